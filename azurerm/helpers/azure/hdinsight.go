@@ -119,6 +119,175 @@ func SchemaHDInsightsGateway() *schema.Schema {
 	}
 }
 
+func SchemaHDInsightsSecurityProfile() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				// NOTE: all of these force a new resource, since the HDInsight Clusters API version
+				// vendored by this provider only exposes a `ClusterPatchParameters.Tags` update path -
+				// there's no supported way to rotate the domain identity, join credentials or LDAPS
+				// certificate of an Enterprise Security Package cluster without recreating it.
+				"domain_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				"organizational_unit_dn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				"ldaps_urls": {
+					Type:     schema.TypeList,
+					Required: true,
+					ForceNew: true,
+					MinItems: 1,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+				"domain_username": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				"domain_user_password": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					Sensitive:    true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				"cluster_users_group_dns": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+				"aadds_resource_id": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				"msi_resource_id": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+func ExpandHDInsightsSecurityProfile(input []interface{}) *hdinsight.SecurityProfile {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	ldapsUrlsRaw := v["ldaps_urls"].([]interface{})
+	ldapsUrls := make([]string, 0)
+	for _, item := range ldapsUrlsRaw {
+		ldapsUrls = append(ldapsUrls, item.(string))
+	}
+
+	clusterUsersGroupDNSRaw := v["cluster_users_group_dns"].([]interface{})
+	clusterUsersGroupDNS := make([]string, 0)
+	for _, item := range clusterUsersGroupDNSRaw {
+		clusterUsersGroupDNS = append(clusterUsersGroupDNS, item.(string))
+	}
+
+	return &hdinsight.SecurityProfile{
+		DirectoryType:        hdinsight.ActiveDirectory,
+		Domain:               utils.String(v["domain_name"].(string)),
+		OrganizationalUnitDN: utils.String(v["organizational_unit_dn"].(string)),
+		LdapsUrls:            &ldapsUrls,
+		DomainUsername:       utils.String(v["domain_username"].(string)),
+		DomainUserPassword:   utils.String(v["domain_user_password"].(string)),
+		ClusterUsersGroupDNS: &clusterUsersGroupDNS,
+		AaddsResourceID:      utils.String(v["aadds_resource_id"].(string)),
+		MsiResourceID:        utils.String(v["msi_resource_id"].(string)),
+	}
+}
+
+func FlattenHDInsightsSecurityProfile(input *hdinsight.SecurityProfile, d *schema.ResourceData) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	// the Azure API redacts `domainUserPassword` - so we pull this from the existing config instead
+	password := ""
+	if v, ok := d.GetOk("security_profile.0.domain_user_password"); ok {
+		password = v.(string)
+	}
+
+	domain := ""
+	if input.Domain != nil {
+		domain = *input.Domain
+	}
+
+	organizationalUnitDN := ""
+	if input.OrganizationalUnitDN != nil {
+		organizationalUnitDN = *input.OrganizationalUnitDN
+	}
+
+	ldapsUrls := make([]interface{}, 0)
+	if input.LdapsUrls != nil {
+		for _, item := range *input.LdapsUrls {
+			ldapsUrls = append(ldapsUrls, item)
+		}
+	}
+
+	domainUsername := ""
+	if input.DomainUsername != nil {
+		domainUsername = *input.DomainUsername
+	}
+
+	clusterUsersGroupDNS := make([]interface{}, 0)
+	if input.ClusterUsersGroupDNS != nil {
+		for _, item := range *input.ClusterUsersGroupDNS {
+			clusterUsersGroupDNS = append(clusterUsersGroupDNS, item)
+		}
+	}
+
+	aaddsResourceId := ""
+	if input.AaddsResourceID != nil {
+		aaddsResourceId = *input.AaddsResourceID
+	}
+
+	msiResourceId := ""
+	if input.MsiResourceID != nil {
+		msiResourceId = *input.MsiResourceID
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"domain_name":             domain,
+			"organizational_unit_dn":  organizationalUnitDN,
+			"ldaps_urls":              ldapsUrls,
+			"domain_username":         domainUsername,
+			"domain_user_password":    password,
+			"cluster_users_group_dns": clusterUsersGroupDNS,
+			"aadds_resource_id":       aaddsResourceId,
+			"msi_resource_id":         msiResourceId,
+		},
+	}
+}
+
 func SchemaHDInsightsExternalMetastore() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
@@ -617,6 +786,9 @@ func ValidateSchemaHDInsightNodeDefinitionVMSize() schema.SchemaValidateFunc {
 
 func SchemaHDInsightNodeDefinition(schemaLocation string, definition HDInsightNodeDefinition) *schema.Schema {
 	result := map[string]*schema.Schema{
+		// `vm_size` has to stay ForceNew - the vendored HDInsight Management API's `ClusterResizeParameters` only
+		// carries a `TargetInstanceCount`, with no VM size field, so there's no Resize-style call this provider
+		// could make to reprovision a role's nodes onto a different VM size in place.
 		"vm_size": {
 			Type:             schema.TypeString,
 			Required:         true,
@@ -635,6 +807,9 @@ func SchemaHDInsightNodeDefinition(schemaLocation string, definition HDInsightNo
 			ForceNew:  true,
 			Sensitive: true,
 		},
+		// `ssh_keys` already supports specifying multiple public keys (it's a Set), but it has to stay ForceNew -
+		// the vendored HDInsight Management API's `ClusterPatchParameters` only carries `Tags`, so there's no
+		// patch-style call this provider could make to rotate the keys on an existing cluster in place.
 		"ssh_keys": {
 			Type:     schema.TypeSet,
 			Optional: true,
@@ -4,6 +4,7 @@ type UserFeatures struct {
 	VirtualMachine         VirtualMachineFeatures
 	VirtualMachineScaleSet VirtualMachineScaleSetFeatures
 	KeyVault               KeyVaultFeatures
+	Storage                StorageFeatures
 }
 
 type VirtualMachineFeatures struct {
@@ -18,3 +19,7 @@ type KeyVaultFeatures struct {
 	PurgeSoftDeleteOnDestroy    bool
 	RecoverSoftDeletedKeyVaults bool
 }
+
+type StorageFeatures struct {
+	DataPlaneAvailable bool
+}
@@ -55,6 +55,20 @@ func schemaFeatures(supportLegacyTestSuite bool) *schema.Schema {
 				},
 			},
 		},
+
+		"storage": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"data_plane_available": {
+						Type:     schema.TypeBool,
+						Optional: true,
+					},
+				},
+			},
+		},
 	}
 
 	// this is a temporary hack to enable us to gradually add provider blocks to test configurations
@@ -94,6 +108,9 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 			PurgeSoftDeleteOnDestroy:    true,
 			RecoverSoftDeletedKeyVaults: true,
 		},
+		Storage: features.StorageFeatures{
+			DataPlaneAvailable: true,
+		},
 	}
 
 	if len(input) == 0 || input[0] == nil {
@@ -135,5 +152,15 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["storage"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 {
+			storageRaw := items[0].(map[string]interface{})
+			if v, ok := storageRaw["data_plane_available"]; ok {
+				features.Storage.DataPlaneAvailable = v.(bool)
+			}
+		}
+	}
+
 	return features
 }
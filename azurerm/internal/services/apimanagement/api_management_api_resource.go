@@ -1,8 +1,13 @@
 package apimanagement
 
 import (
+	"crypto/sha1"
+	"encoding/hex"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"log"
+	"net/http"
 	"strings"
 	"time"
 
@@ -34,6 +39,11 @@ func resourceArmApiManagementApi() *schema.Resource {
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
+		// `import.0.content_value` is only diffed against the previous config/state, so a `*-link` format whose
+		// source file changes at the same URL is never noticed by a plan. This hashes the resolved source content
+		// on every plan and forces a diff (and therefore a re-import) when it drifts, without requiring `taint`.
+		CustomizeDiff: apiManagementApiImportContentDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -132,6 +142,14 @@ func resourceArmApiManagementApi() *schema.Resource {
 								},
 							},
 						},
+
+						// A SHA1 hash of the resolved source API definition - for a `*-link` content format this is
+						// the content the link currently resolves to, not the link string itself. Maintained by
+						// CustomizeDiff so the API is re-imported when the source content changes upstream.
+						"content_value_hash": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -430,6 +448,72 @@ func resourceArmApiManagementApiDelete(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+func apiManagementApiImportContentDiff(d *schema.ResourceDiff, meta interface{}) error {
+	importRaw, ok := d.GetOk("import")
+	if !ok {
+		return nil
+	}
+
+	importList := importRaw.([]interface{})
+	if len(importList) == 0 || importList[0] == nil {
+		return nil
+	}
+	importV := importList[0].(map[string]interface{})
+
+	contentFormat := importV["content_format"].(string)
+	contentValue := importV["content_value"].(string)
+	oldHash := importV["content_value_hash"].(string)
+
+	newHash, err := hashApiManagementApiImportContent(contentFormat, contentValue)
+	if err != nil {
+		// Don't fail the plan over a transient error resolving the source spec - fall back to whatever
+		// diff `content_value`/`content_format` already produce on their own. This is logged at WARN
+		// (rather than DEBUG) because it's also the permanent behaviour when the source URL isn't
+		// reachable from the machine running `terraform plan` (behind auth, a private network, etc) -
+		// drift detection silently stops working in that case, and this is the only signal of it.
+		log.Printf("[WARN] Unable to determine content hash for API Management API import: %+v", err)
+		return nil
+	}
+
+	if newHash != oldHash {
+		importV["content_value_hash"] = newHash
+		if err := d.SetNew("import", []interface{}{importV}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// hashApiManagementApiImportContent returns a SHA1 hash of the API definition content described by
+// contentValue. For `*-link` content formats contentValue is a URL, so the definition it currently
+// resolves to is fetched and hashed rather than the URL string itself.
+func hashApiManagementApiImportContent(contentFormat, contentValue string) (string, error) {
+	content := []byte(contentValue)
+
+	if strings.Contains(contentFormat, "link") {
+		client := &http.Client{Timeout: 30 * time.Second}
+		resp, err := client.Get(contentValue)
+		if err != nil {
+			return "", fmt.Errorf("fetching source API definition from %q: %+v", contentValue, err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return "", fmt.Errorf("fetching source API definition from %q: unexpected status %d", contentValue, resp.StatusCode)
+		}
+
+		body, err := ioutil.ReadAll(io.LimitReader(resp.Body, 20*1024*1024))
+		if err != nil {
+			return "", fmt.Errorf("reading source API definition from %q: %+v", contentValue, err)
+		}
+		content = body
+	}
+
+	sum := sha1.Sum(content)
+	return hex.EncodeToString(sum[:]), nil
+}
+
 func expandApiManagementApiProtocols(input []interface{}) *[]apimanagement.Protocol {
 	results := make([]apimanagement.Protocol, 0)
 
@@ -0,0 +1,84 @@
+package apimanagement
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHashApiManagementApiImportContent_inline(t *testing.T) {
+	contentValue := `{"swagger": "2.0"}`
+
+	hash, err := hashApiManagementApiImportContent("swagger-json", contentValue)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	expected := sha1Hex(contentValue)
+	if hash != expected {
+		t.Fatalf("Expected inline content to be hashed directly - expected %q but got %q", expected, hash)
+	}
+}
+
+func TestHashApiManagementApiImportContent_link(t *testing.T) {
+	body := `{"swagger": "2.0", "info": {"title": "example"}}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	hash, err := hashApiManagementApiImportContent("swagger-link-json", server.URL)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	expected := sha1Hex(body)
+	if hash != expected {
+		t.Fatalf("Expected the hash of the resolved link content - expected %q but got %q", expected, hash)
+	}
+
+	// the resolved content should be hashed rather than the URL string itself
+	if hash == sha1Hex(server.URL) {
+		t.Fatalf("Expected the hash of the resolved content, not the link URL")
+	}
+}
+
+func TestHashApiManagementApiImportContent_linkNon200(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	if _, err := hashApiManagementApiImportContent("swagger-link-json", server.URL); err == nil {
+		t.Fatalf("Expected an error for a non-200 response but got none")
+	}
+}
+
+func TestHashApiManagementApiImportContent_linkOversizedBodyIsTruncated(t *testing.T) {
+	const limit = 20 * 1024 * 1024
+	body := strings.Repeat("a", limit+1024)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	hash, err := hashApiManagementApiImportContent("swagger-link-json", server.URL)
+	if err != nil {
+		t.Fatalf("Expected no error for an oversized response but got: %+v", err)
+	}
+
+	expected := sha1Hex(body[:limit])
+	if hash != expected {
+		t.Fatalf("Expected the hash of the content truncated to %d bytes - expected %q but got %q", limit, expected, hash)
+	}
+}
+
+func sha1Hex(input string) string {
+	sum := sha1.Sum([]byte(input))
+	return hex.EncodeToString(sum[:])
+}
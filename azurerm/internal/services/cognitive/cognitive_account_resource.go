@@ -236,7 +236,7 @@ func resourceArmCognitiveAccountRead(d *schema.ResourceData, meta interface{}) e
 			d.SetId("")
 			return nil
 		}
-		return err
+		return fmt.Errorf("Error retrieving Cognitive Services Account %q (Resource Group %q): %+v%s", id.Name, id.ResourceGroup, err, utils.AzureRequestCorrelationDetails(resp.Response))
 	}
 
 	d.Set("name", id.Name)
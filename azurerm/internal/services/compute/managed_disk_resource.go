@@ -120,6 +120,12 @@ func resourceArmManagedDisk() *schema.Resource {
 				ValidateFunc: validateManagedDiskSizeGB,
 			},
 
+			"allow_online_resize": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"disk_iops_read_write": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -354,7 +360,9 @@ func resourceArmManagedDiskUpdate(d *schema.ResourceData, meta interface{}) erro
 
 	if d.HasChange("disk_size_gb") {
 		if old, new := d.GetChange("disk_size_gb"); new.(int) > old.(int) {
-			shouldShutDown = true
+			if !d.Get("allow_online_resize").(bool) {
+				shouldShutDown = true
+			}
 			diskUpdate.DiskUpdateProperties.DiskSizeGB = utils.Int32(int32(new.(int)))
 		} else {
 			return fmt.Errorf("Error - New size must be greater than original size. Shrinking disks is not supported on Azure")
@@ -70,6 +70,20 @@ func resourceArmOrchestratedVirtualMachineScaleSet() *schema.Resource {
 			// the VMO mode can only be deployed into one zone for now, and its zone will also be assigned to all its VM instances
 			"zones": azure.SchemaSingleZone(),
 
+			"upgrade_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(compute.Manual),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(compute.Automatic),
+					string(compute.Manual),
+					string(compute.Rolling),
+				}, false),
+			},
+
+			"rolling_upgrade_policy": VirtualMachineScaleSetRollingUpgradePolicySchema(),
+
 			"unique_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -101,12 +115,28 @@ func resourceArmOrchestratedVirtualMachineScaleSetCreateUpdate(d *schema.Resourc
 		}
 	}
 
+	upgradeMode := compute.UpgradeMode(d.Get("upgrade_mode").(string))
+	rollingUpgradePolicyRaw := d.Get("rolling_upgrade_policy").([]interface{})
+	rollingUpgradePolicy := ExpandVirtualMachineScaleSetRollingUpgradePolicy(rollingUpgradePolicyRaw)
+
+	shouldHaveRollingUpgradePolicy := upgradeMode == compute.Automatic || upgradeMode == compute.Rolling
+	if !shouldHaveRollingUpgradePolicy && len(rollingUpgradePolicyRaw) > 0 {
+		return fmt.Errorf("a `rolling_upgrade_policy` block cannot be specified when `upgrade_mode` is set to %q", string(upgradeMode))
+	}
+	if shouldHaveRollingUpgradePolicy && len(rollingUpgradePolicyRaw) == 0 {
+		return fmt.Errorf("a `rolling_upgrade_policy` block must be specified when `upgrade_mode` is set to %q", string(upgradeMode))
+	}
+
 	props := compute.VirtualMachineScaleSet{
 		Location: utils.String(location.Normalize(d.Get("location").(string))),
 		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
 		VirtualMachineScaleSetProperties: &compute.VirtualMachineScaleSetProperties{
 			PlatformFaultDomainCount: utils.Int32(int32(d.Get("platform_fault_domain_count").(int))),
 			SinglePlacementGroup:     utils.Bool(d.Get("single_placement_group").(bool)),
+			UpgradePolicy: &compute.UpgradePolicy{
+				Mode:                 upgradeMode,
+				RollingUpgradePolicy: rollingUpgradePolicy,
+			},
 		},
 		Zones: azure.ExpandZones(d.Get("zones").([]interface{})),
 	}
@@ -162,6 +192,14 @@ func resourceArmOrchestratedVirtualMachineScaleSetRead(d *schema.ResourceData, m
 		d.Set("platform_fault_domain_count", props.PlatformFaultDomainCount)
 		d.Set("single_placement_group", props.SinglePlacementGroup)
 		d.Set("unique_id", props.UniqueID)
+
+		if policy := props.UpgradePolicy; policy != nil {
+			d.Set("upgrade_mode", string(policy.Mode))
+
+			if err := d.Set("rolling_upgrade_policy", FlattenVirtualMachineScaleSetRollingUpgradePolicy(policy.RollingUpgradePolicy)); err != nil {
+				return fmt.Errorf("setting `rolling_upgrade_policy`: %+v", err)
+			}
+		}
 	}
 
 	if err := d.Set("zones", resp.Zones); err != nil {
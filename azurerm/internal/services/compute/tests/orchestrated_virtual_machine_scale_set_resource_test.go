@@ -2,6 +2,7 @@ package tests
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -102,6 +103,63 @@ func TestAccAzureRMOrchestratedVirtualMachineScaleSet_requiresImport(t *testing.
 	})
 }
 
+func TestAccAzureRMOrchestratedVirtualMachineScaleSet_rollingUpgradeMode(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_orchestrated_virtual_machine_scale_set", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMOrchestratedVirtualMachineScaleSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMOrchestratedVirtualMachineScaleSet_rollingUpgradeMode(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMOrchestratedVirtualMachineScaleSetExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "upgrade_mode", "Rolling"),
+					resource.TestCheckResourceAttr(data.ResourceName, "rolling_upgrade_policy.#", "1"),
+					resource.TestCheckResourceAttr(data.ResourceName, "rolling_upgrade_policy.0.max_batch_instance_percent", "21"),
+					resource.TestCheckResourceAttr(data.ResourceName, "rolling_upgrade_policy.0.max_unhealthy_instance_percent", "22"),
+					resource.TestCheckResourceAttr(data.ResourceName, "rolling_upgrade_policy.0.max_unhealthy_upgraded_instance_percent", "23"),
+					resource.TestCheckResourceAttr(data.ResourceName, "rolling_upgrade_policy.0.pause_time_between_batches", "PT30S"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func TestAccAzureRMOrchestratedVirtualMachineScaleSet_rollingUpgradePolicyRequiresRollingMode(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_orchestrated_virtual_machine_scale_set", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMOrchestratedVirtualMachineScaleSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAzureRMOrchestratedVirtualMachineScaleSet_manualModeWithRollingUpgradePolicy(data),
+				ExpectError: regexp.MustCompile("a `rolling_upgrade_policy` block cannot be specified when `upgrade_mode` is set to \"Manual\""),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMOrchestratedVirtualMachineScaleSet_rollingModeRequiresRollingUpgradePolicy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_orchestrated_virtual_machine_scale_set", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMOrchestratedVirtualMachineScaleSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAzureRMOrchestratedVirtualMachineScaleSet_rollingModeWithoutRollingUpgradePolicy(data),
+				ExpectError: regexp.MustCompile("a `rolling_upgrade_policy` block must be specified when `upgrade_mode` is set to \"Rolling\""),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMOrchestratedVirtualMachineScaleSetDestroy(s *terraform.State) error {
 	client := acceptance.AzureProvider.Meta().(*clients.Client).Compute.VMScaleSetClient
 	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
@@ -236,6 +294,81 @@ resource "azurerm_orchestrated_virtual_machine_scale_set" "test" {
 `, template, data.RandomInteger)
 }
 
+func testAccAzureRMOrchestratedVirtualMachineScaleSet_rollingUpgradeMode(data acceptance.TestData) string {
+	template := testAccAzureRMOrchestratedVirtualMachineScaleSet_template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_orchestrated_virtual_machine_scale_set" "test" {
+  name                = "acctestVMO-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  platform_fault_domain_count = 1
+
+  zones = ["1"]
+
+  upgrade_mode = "Rolling"
+
+  rolling_upgrade_policy {
+    max_batch_instance_percent              = 21
+    max_unhealthy_instance_percent          = 22
+    max_unhealthy_upgraded_instance_percent = 23
+    pause_time_between_batches              = "PT30S"
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, template, data.RandomInteger)
+}
+
+func testAccAzureRMOrchestratedVirtualMachineScaleSet_manualModeWithRollingUpgradePolicy(data acceptance.TestData) string {
+	template := testAccAzureRMOrchestratedVirtualMachineScaleSet_template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_orchestrated_virtual_machine_scale_set" "test" {
+  name                = "acctestVMO-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  platform_fault_domain_count = 1
+
+  zones = ["1"]
+
+  upgrade_mode = "Manual"
+
+  rolling_upgrade_policy {
+    max_batch_instance_percent              = 21
+    max_unhealthy_instance_percent          = 22
+    max_unhealthy_upgraded_instance_percent = 23
+    pause_time_between_batches              = "PT30S"
+  }
+}
+`, template, data.RandomInteger)
+}
+
+func testAccAzureRMOrchestratedVirtualMachineScaleSet_rollingModeWithoutRollingUpgradePolicy(data acceptance.TestData) string {
+	template := testAccAzureRMOrchestratedVirtualMachineScaleSet_template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_orchestrated_virtual_machine_scale_set" "test" {
+  name                = "acctestVMO-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  platform_fault_domain_count = 1
+
+  zones = ["1"]
+
+  upgrade_mode = "Rolling"
+}
+`, template, data.RandomInteger)
+}
+
 func testAccAzureRMOrchestratedVirtualMachineScaleSet_template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,441 @@
+package datafactory
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmDataFactoryDatasetJSON() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDataFactoryDatasetJSONCreateUpdate,
+		Read:   resourceArmDataFactoryDatasetJSONRead,
+		Update: resourceArmDataFactoryDatasetJSONCreateUpdate,
+		Delete: resourceArmDataFactoryDatasetJSONDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMDataFactoryLinkedServiceDatasetName,
+			},
+
+			"data_factory_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DataFactoryName(),
+			},
+
+			// There's a bug in the Azure API where this is returned in lower-case
+			// BUG: https://github.com/Azure/azure-rest-api-specs/issues/5788
+			"resource_group_name": azure.SchemaResourceGroupNameDiffSuppress(),
+
+			"linked_service_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"azure_blob_storage_location": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"container": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"path": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"filename": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"encoding": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			// The `schema_column` list below only round-trips a flat column list (`Structure`), which can't express
+			// a nested document schema. `schema_json` instead round-trips the dataset's raw `schema` (physical
+			// schema) property, which the Data Factory API models as a free-form, potentially nested JSON value.
+			"schema_json": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"annotations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"folder": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"additional_properties": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"schema_column": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"Byte",
+								"Byte[]",
+								"Boolean",
+								"Date",
+								"DateTime",
+								"DateTimeOffset",
+								"Decimal",
+								"Double",
+								"Guid",
+								"Int16",
+								"Int32",
+								"Int64",
+								"Single",
+								"String",
+								"TimeSpan",
+							}, false),
+						},
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmDataFactoryDatasetJSONCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.DatasetClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	dataFactoryName := d.Get("data_factory_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Data Factory Dataset JSON %q (Data Factory %q / Resource Group %q): %s", name, dataFactoryName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_data_factory_dataset_json", *existing.ID)
+		}
+	}
+
+	jsonDatasetProperties := datafactory.JSONDatasetTypeProperties{}
+
+	if v, ok := d.GetOk("azure_blob_storage_location"); ok {
+		jsonDatasetProperties.Location = expandDataFactoryDatasetAzureBlobStorageLocation(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("encoding"); ok {
+		jsonDatasetProperties.EncodingName = v.(string)
+	}
+
+	linkedServiceName := d.Get("linked_service_name").(string)
+	linkedServiceType := "LinkedServiceReference"
+	linkedService := &datafactory.LinkedServiceReference{
+		ReferenceName: &linkedServiceName,
+		Type:          &linkedServiceType,
+	}
+
+	description := d.Get("description").(string)
+	jsonDataset := datafactory.JSONDataset{
+		JSONDatasetTypeProperties: &jsonDatasetProperties,
+		LinkedServiceName:         linkedService,
+		Description:               &description,
+	}
+
+	if v, ok := d.GetOk("folder"); ok {
+		name := v.(string)
+		jsonDataset.Folder = &datafactory.DatasetFolder{
+			Name: &name,
+		}
+	}
+
+	if v, ok := d.GetOk("parameters"); ok {
+		jsonDataset.Parameters = expandDataFactoryParameters(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("annotations"); ok {
+		annotations := v.([]interface{})
+		jsonDataset.Annotations = &annotations
+	}
+
+	if v, ok := d.GetOk("additional_properties"); ok {
+		jsonDataset.AdditionalProperties = v.(map[string]interface{})
+	}
+
+	if v, ok := d.GetOk("schema_column"); ok {
+		jsonDataset.Structure = expandDataFactoryDatasetStructure(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("schema_json"); ok {
+		var schemaValue interface{}
+		if err := json.Unmarshal([]byte(v.(string)), &schemaValue); err != nil {
+			return fmt.Errorf("Error parsing `schema_json`: %+v", err)
+		}
+		jsonDataset.Schema = schemaValue
+	}
+
+	datasetType := string(datafactory.TypeJSON)
+	dataset := datafactory.DatasetResource{
+		Properties: &jsonDataset,
+		Type:       &datasetType,
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, dataFactoryName, name, dataset, ""); err != nil {
+		return fmt.Errorf("Error creating/updating Data Factory Dataset JSON %q (Data Factory %q / Resource Group %q): %s", name, dataFactoryName, resourceGroup, err)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Data Factory Dataset JSON %q (Data Factory %q / Resource Group %q): %s", name, dataFactoryName, resourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Data Factory Dataset JSON %q (Data Factory %q / Resource Group %q): %s", name, dataFactoryName, resourceGroup, err)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmDataFactoryDatasetJSONRead(d, meta)
+}
+
+func resourceArmDataFactoryDatasetJSONRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.DatasetClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	dataFactoryName := id.Path["factories"]
+	name := id.Path["datasets"]
+
+	resp, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Data Factory Dataset JSON %q (Data Factory %q / Resource Group %q): %s", name, dataFactoryName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("data_factory_name", dataFactoryName)
+
+	jsonTable, ok := resp.Properties.AsJSONDataset()
+	if !ok {
+		return fmt.Errorf("Error classifiying Data Factory Dataset JSON %q (Data Factory %q / Resource Group %q): Expected: %q Received: %q", name, dataFactoryName, resourceGroup, datafactory.TypeJSON, *resp.Type)
+	}
+
+	d.Set("additional_properties", jsonTable.AdditionalProperties)
+
+	if jsonTable.Description != nil {
+		d.Set("description", jsonTable.Description)
+	}
+
+	parameters := flattenDataFactoryParameters(jsonTable.Parameters)
+	if err := d.Set("parameters", parameters); err != nil {
+		return fmt.Errorf("Error setting `parameters`: %+v", err)
+	}
+
+	annotations := flattenDataFactoryAnnotations(jsonTable.Annotations)
+	if err := d.Set("annotations", annotations); err != nil {
+		return fmt.Errorf("Error setting `annotations`: %+v", err)
+	}
+
+	if linkedService := jsonTable.LinkedServiceName; linkedService != nil {
+		if linkedService.ReferenceName != nil {
+			d.Set("linked_service_name", linkedService.ReferenceName)
+		}
+	}
+
+	if properties := jsonTable.JSONDatasetTypeProperties; properties != nil {
+		if location := expandDataFactoryDatasetAzureBlobStorageLocationRead(properties.Location); location != nil {
+			if err := d.Set("azure_blob_storage_location", location); err != nil {
+				return fmt.Errorf("Error setting `azure_blob_storage_location`: %+v", err)
+			}
+		}
+
+		if encoding, ok := properties.EncodingName.(string); ok {
+			d.Set("encoding", encoding)
+		}
+	}
+
+	if folder := jsonTable.Folder; folder != nil {
+		if folder.Name != nil {
+			d.Set("folder", folder.Name)
+		}
+	}
+
+	structureColumns := flattenDataFactoryStructureColumns(jsonTable.Structure)
+	if err := d.Set("schema_column", structureColumns); err != nil {
+		return fmt.Errorf("Error setting `schema_column`: %+v", err)
+	}
+
+	if jsonTable.Schema != nil {
+		schemaBytes, err := json.Marshal(jsonTable.Schema)
+		if err != nil {
+			return fmt.Errorf("Error serializing `schema_json`: %+v", err)
+		}
+		d.Set("schema_json", string(schemaBytes))
+	}
+
+	return nil
+}
+
+func resourceArmDataFactoryDatasetJSONDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.DatasetClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	dataFactoryName := id.Path["factories"]
+	name := id.Path["datasets"]
+
+	response, err := client.Delete(ctx, resourceGroup, dataFactoryName, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(response) {
+			return fmt.Errorf("Error deleting Data Factory Dataset JSON %q (Data Factory %q / Resource Group %q): %s", name, dataFactoryName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandDataFactoryDatasetAzureBlobStorageLocation(input []interface{}) *datafactory.AzureBlobStorageLocation {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	attrs := input[0].(map[string]interface{})
+
+	location := &datafactory.AzureBlobStorageLocation{
+		Container: attrs["container"].(string),
+	}
+
+	if v, ok := attrs["path"].(string); ok && v != "" {
+		location.FolderPath = v
+	}
+
+	if v, ok := attrs["filename"].(string); ok && v != "" {
+		location.FileName = v
+	}
+
+	return location
+}
+
+func expandDataFactoryDatasetAzureBlobStorageLocationRead(input datafactory.BasicDatasetLocation) []interface{} {
+	if input == nil {
+		return nil
+	}
+
+	location, ok := input.AsAzureBlobStorageLocation()
+	if !ok {
+		return nil
+	}
+
+	result := make(map[string]interface{})
+
+	if container, ok := location.Container.(string); ok {
+		result["container"] = container
+	}
+	if path, ok := location.FolderPath.(string); ok {
+		result["path"] = path
+	}
+	if filename, ok := location.FileName.(string); ok {
+		result["filename"] = filename
+	}
+
+	return []interface{}{result}
+}
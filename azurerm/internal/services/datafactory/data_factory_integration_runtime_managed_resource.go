@@ -196,6 +196,85 @@ func resourceArmDataFactoryIntegrationRuntimeManaged() *schema.Resource {
 					},
 				},
 			},
+
+			"express_custom_setup": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"component": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+									"license_key": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Sensitive:    true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+							},
+						},
+
+						"environment_variable": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"command_key": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"target_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+									"user_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+									"password": {
+										Type:         schema.TypeString,
+										Required:     true,
+										Sensitive:    true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"package_store": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"linked_service_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -326,6 +405,14 @@ func resourceArmDataFactoryIntegrationRuntimeManagedRead(d *schema.ResourceData,
 		if err := d.Set("custom_setup_script", flattenArmDataFactoryIntegrationRuntimeManagedSsisCustomSetupScript(ssisProps.CustomSetupScriptProperties, d)); err != nil {
 			return fmt.Errorf("Error setting `vnet_integration`: %+v", err)
 		}
+
+		if err := d.Set("express_custom_setup", flattenArmDataFactoryIntegrationRuntimeManagedSsisExpressCustomSetup(ssisProps.ExpressCustomSetupProperties, d)); err != nil {
+			return fmt.Errorf("Error setting `express_custom_setup`: %+v", err)
+		}
+
+		if err := d.Set("package_store", flattenArmDataFactoryIntegrationRuntimeManagedSsisPackageStores(ssisProps.PackageStores)); err != nil {
+			return fmt.Errorf("Error setting `package_store`: %+v", err)
+		}
 	}
 
 	return nil
@@ -410,6 +497,73 @@ func expandArmDataFactoryIntegrationRuntimeManagedSsisProperties(d *schema.Resou
 		}
 	}
 
+	if expressCustomSetups, ok := d.GetOk("express_custom_setup"); ok && len(expressCustomSetups.([]interface{})) > 0 {
+		expressCustomSetup := expressCustomSetups.([]interface{})[0].(map[string]interface{})
+
+		customSetupBases := make([]datafactory.BasicCustomSetupBase, 0)
+
+		for _, v := range expressCustomSetup["component"].([]interface{}) {
+			component := v.(map[string]interface{})
+
+			customSetupBases = append(customSetupBases, &datafactory.ComponentSetup{
+				Type: datafactory.TypeComponentSetup,
+				LicensedComponentSetupTypeProperties: &datafactory.LicensedComponentSetupTypeProperties{
+					ComponentName: utils.String(component["name"].(string)),
+					LicenseKey: &datafactory.SecureString{
+						Value: utils.String(component["license_key"].(string)),
+						Type:  datafactory.TypeSecureString,
+					},
+				},
+			})
+		}
+
+		for name, value := range expressCustomSetup["environment_variable"].(map[string]interface{}) {
+			customSetupBases = append(customSetupBases, &datafactory.EnvironmentVariableSetup{
+				Type: datafactory.TypeEnvironmentVariableSetup,
+				EnvironmentVariableSetupTypeProperties: &datafactory.EnvironmentVariableSetupTypeProperties{
+					VariableName:  utils.String(name),
+					VariableValue: utils.String(value.(string)),
+				},
+			})
+		}
+
+		for _, v := range expressCustomSetup["command_key"].([]interface{}) {
+			commandKey := v.(map[string]interface{})
+
+			customSetupBases = append(customSetupBases, &datafactory.CmdkeySetup{
+				Type: datafactory.TypeCmdkeySetup,
+				CmdkeySetupTypeProperties: &datafactory.CmdkeySetupTypeProperties{
+					TargetName: commandKey["target_name"].(string),
+					UserName:   commandKey["user_name"].(string),
+					Password: &datafactory.SecureString{
+						Value: utils.String(commandKey["password"].(string)),
+						Type:  datafactory.TypeSecureString,
+					},
+				},
+			})
+		}
+
+		ssisProperties.ExpressCustomSetupProperties = &customSetupBases
+	}
+
+	if packageStoresRaw, ok := d.GetOk("package_store"); ok {
+		packageStores := make([]datafactory.PackageStore, 0)
+
+		for _, v := range packageStoresRaw.([]interface{}) {
+			packageStore := v.(map[string]interface{})
+
+			packageStores = append(packageStores, datafactory.PackageStore{
+				Name: utils.String(packageStore["name"].(string)),
+				PackageStoreLinkedService: &datafactory.EntityReference{
+					Type:          datafactory.IntegrationRuntimeEntityReferenceTypeLinkedServiceReference,
+					ReferenceName: utils.String(packageStore["linked_service_name"].(string)),
+				},
+			})
+		}
+
+		ssisProperties.PackageStores = &packageStores
+	}
+
 	return ssisProperties
 }
 
@@ -459,3 +613,80 @@ func flattenArmDataFactoryIntegrationRuntimeManagedSsisCustomSetupScript(customS
 
 	return []interface{}{customSetupScript}
 }
+
+func flattenArmDataFactoryIntegrationRuntimeManagedSsisExpressCustomSetup(customSetupBases *[]datafactory.BasicCustomSetupBase, d *schema.ResourceData) []interface{} {
+	if customSetupBases == nil || len(*customSetupBases) == 0 {
+		return []interface{}{}
+	}
+
+	existingComponents := d.Get("express_custom_setup.0.component").([]interface{})
+	existingCommandKeys := d.Get("express_custom_setup.0.command_key").([]interface{})
+
+	components := make([]interface{}, 0)
+	environmentVariables := make(map[string]interface{})
+	commandKeys := make([]interface{}, 0)
+
+	for _, base := range *customSetupBases {
+		switch setup := base.(type) {
+		case datafactory.ComponentSetup:
+			component := map[string]interface{}{}
+			if props := setup.LicensedComponentSetupTypeProperties; props != nil && props.ComponentName != nil {
+				component["name"] = *props.ComponentName
+			}
+			if len(components) < len(existingComponents) {
+				if existing, ok := existingComponents[len(components)].(map[string]interface{}); ok {
+					component["license_key"] = existing["license_key"]
+				}
+			}
+			components = append(components, component)
+		case datafactory.EnvironmentVariableSetup:
+			if props := setup.EnvironmentVariableSetupTypeProperties; props != nil && props.VariableName != nil && props.VariableValue != nil {
+				environmentVariables[*props.VariableName] = *props.VariableValue
+			}
+		case datafactory.CmdkeySetup:
+			commandKey := map[string]interface{}{}
+			if props := setup.CmdkeySetupTypeProperties; props != nil {
+				if targetName, ok := props.TargetName.(string); ok {
+					commandKey["target_name"] = targetName
+				}
+				if userName, ok := props.UserName.(string); ok {
+					commandKey["user_name"] = userName
+				}
+			}
+			if len(commandKeys) < len(existingCommandKeys) {
+				if existing, ok := existingCommandKeys[len(commandKeys)].(map[string]interface{}); ok {
+					commandKey["password"] = existing["password"]
+				}
+			}
+			commandKeys = append(commandKeys, commandKey)
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"component":            components,
+			"environment_variable": environmentVariables,
+			"command_key":          commandKeys,
+		},
+	}
+}
+
+func flattenArmDataFactoryIntegrationRuntimeManagedSsisPackageStores(packageStores *[]datafactory.PackageStore) []interface{} {
+	if packageStores == nil {
+		return []interface{}{}
+	}
+
+	result := make([]interface{}, 0)
+	for _, packageStore := range *packageStores {
+		item := map[string]interface{}{}
+		if packageStore.Name != nil {
+			item["name"] = *packageStore.Name
+		}
+		if linkedService := packageStore.PackageStoreLinkedService; linkedService != nil && linkedService.ReferenceName != nil {
+			item["linked_service_name"] = *linkedService.ReferenceName
+		}
+		result = append(result, item)
+	}
+
+	return result
+}
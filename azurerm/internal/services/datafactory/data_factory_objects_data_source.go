@@ -0,0 +1,139 @@
+package datafactory
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmDataFactoryObjects() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmDataFactoryObjectsRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+
+			"pipelines": dataSourceArmDataFactoryObjectsIDMap(),
+
+			"datasets": dataSourceArmDataFactoryObjectsIDMap(),
+
+			"linked_services": dataSourceArmDataFactoryObjectsIDMap(),
+
+			"triggers": dataSourceArmDataFactoryObjectsIDMap(),
+		},
+	}
+}
+
+func dataSourceArmDataFactoryObjectsIDMap() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+}
+
+func dataSourceArmDataFactoryObjectsRead(d *schema.ResourceData, meta interface{}) error {
+	factoriesClient := meta.(*clients.Client).DataFactory.FactoriesClient
+	pipelinesClient := meta.(*clients.Client).DataFactory.PipelinesClient
+	datasetsClient := meta.(*clients.Client).DataFactory.DatasetClient
+	linkedServicesClient := meta.(*clients.Client).DataFactory.LinkedServiceClient
+	triggersClient := meta.(*clients.Client).DataFactory.TriggersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	factory, err := factoriesClient.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(factory.Response) {
+			return fmt.Errorf("Data Factory %q (Resource Group %q) was not found", name, resourceGroup)
+		}
+		return fmt.Errorf("retrieving Data Factory %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if factory.ID == nil || *factory.ID == "" {
+		return fmt.Errorf("API returned a nil/empty id for Data Factory %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	pipelines := make(map[string]string)
+	pipelineIterator, err := pipelinesClient.ListByFactoryComplete(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("listing Pipelines for Data Factory %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	for pipelineIterator.NotDone() {
+		pipeline := pipelineIterator.Value()
+		if pipeline.Name != nil && pipeline.ID != nil {
+			pipelines[*pipeline.Name] = *pipeline.ID
+		}
+		if err := pipelineIterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing Pipelines for Data Factory %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	datasets := make(map[string]string)
+	datasetIterator, err := datasetsClient.ListByFactoryComplete(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("listing Datasets for Data Factory %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	for datasetIterator.NotDone() {
+		dataset := datasetIterator.Value()
+		if dataset.Name != nil && dataset.ID != nil {
+			datasets[*dataset.Name] = *dataset.ID
+		}
+		if err := datasetIterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing Datasets for Data Factory %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	linkedServices := make(map[string]string)
+	linkedServiceIterator, err := linkedServicesClient.ListByFactoryComplete(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("listing Linked Services for Data Factory %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	for linkedServiceIterator.NotDone() {
+		linkedService := linkedServiceIterator.Value()
+		if linkedService.Name != nil && linkedService.ID != nil {
+			linkedServices[*linkedService.Name] = *linkedService.ID
+		}
+		if err := linkedServiceIterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing Linked Services for Data Factory %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	triggers := make(map[string]string)
+	triggerIterator, err := triggersClient.ListByFactoryComplete(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("listing Triggers for Data Factory %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	for triggerIterator.NotDone() {
+		trigger := triggerIterator.Value()
+		if trigger.Name != nil && trigger.ID != nil {
+			triggers[*trigger.Name] = *trigger.ID
+		}
+		if err := triggerIterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing Triggers for Data Factory %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/objects", *factory.ID))
+	d.Set("pipelines", pipelines)
+	d.Set("datasets", datasets)
+	d.Set("linked_services", linkedServices)
+	d.Set("triggers", triggers)
+
+	return nil
+}
@@ -0,0 +1,206 @@
+package datafactory
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// resourceArmDataFactoryPipelineRun is an "action" resource - `terraform apply` triggers a
+// Data Factory Pipeline Run (optionally waiting for it to finish), rather than managing the
+// lifecycle of a long-lived Azure object. Recreating it (e.g. by tainting it, or bumping
+// `run_trigger`) triggers another run.
+func resourceArmDataFactoryPipelineRun() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDataFactoryPipelineRunCreate,
+		Read:   resourceArmDataFactoryPipelineRunRead,
+		Delete: resourceArmDataFactoryPipelineRunDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(24 * time.Hour),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"data_factory_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"pipeline_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DataFactoryPipelineAndTriggerName(),
+			},
+
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			// bump this to force another run of the pipeline without changing anything else
+			"run_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+
+			"run_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmDataFactoryPipelineRunCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.PipelinesClient
+	runsClient := meta.(*clients.Client).DataFactory.PipelineRunsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	dataFactoryId, err := azure.ParseAzureResourceID(d.Get("data_factory_id").(string))
+	if err != nil {
+		return err
+	}
+	resourceGroup := dataFactoryId.ResourceGroup
+	factoryName := dataFactoryId.Path["factories"]
+	pipelineName := d.Get("pipeline_name").(string)
+
+	parameters := map[string]interface{}{}
+	for k, v := range d.Get("parameters").(map[string]interface{}) {
+		parameters[k] = v
+	}
+
+	log.Printf("[DEBUG] Triggering Data Factory Pipeline Run for Pipeline %q (Data Factory %q / Resource Group %q)", pipelineName, factoryName, resourceGroup)
+	run, err := client.CreateRun(ctx, resourceGroup, factoryName, pipelineName, "", nil, "", nil, parameters)
+	if err != nil {
+		return fmt.Errorf("triggering Pipeline Run for Pipeline %q (Data Factory %q / Resource Group %q): %+v", pipelineName, factoryName, resourceGroup, err)
+	}
+
+	if run.RunID == nil {
+		return fmt.Errorf("triggering Pipeline Run for Pipeline %q (Data Factory %q / Resource Group %q): `runId` was nil in the response", pipelineName, factoryName, resourceGroup)
+	}
+	runId := *run.RunID
+
+	d.SetId(fmt.Sprintf("%s/pipelineruns/%s", d.Get("data_factory_id").(string), runId))
+
+	if d.Get("wait_for_completion").(bool) {
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"InProgress", "Queued", "Canceling"},
+			Target:     []string{"Succeeded"},
+			Refresh:    dataFactoryPipelineRunStateRefreshFunc(ctx, runsClient, resourceGroup, factoryName, runId),
+			MinTimeout: 15 * time.Second,
+			Timeout:    d.Timeout(schema.TimeoutCreate),
+		}
+
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("waiting for Pipeline Run %q (Pipeline %q / Data Factory %q / Resource Group %q) to complete: %+v", runId, pipelineName, factoryName, resourceGroup, err)
+		}
+	}
+
+	return resourceArmDataFactoryPipelineRunRead(d, meta)
+}
+
+func resourceArmDataFactoryPipelineRunRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.PipelineRunsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	factoryName := id.Path["factories"]
+	runId := id.Path["pipelineruns"]
+
+	run, err := client.Get(ctx, resourceGroup, factoryName, runId)
+	if err != nil {
+		if utils.ResponseWasNotFound(run.Response) {
+			log.Printf("[DEBUG] Pipeline Run %q (Data Factory %q / Resource Group %q) was not found - removing from state", runId, factoryName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving Pipeline Run %q (Data Factory %q / Resource Group %q): %+v", runId, factoryName, resourceGroup, err)
+	}
+
+	dataFactoryId := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DataFactory/factories/%s", id.SubscriptionID, resourceGroup, factoryName)
+	d.Set("data_factory_id", dataFactoryId)
+	d.Set("pipeline_name", run.PipelineName)
+	d.Set("run_id", run.RunID)
+	if run.Status != nil {
+		d.Set("status", run.Status)
+	}
+
+	parameters := make(map[string]interface{}, len(run.Parameters))
+	for k, v := range run.Parameters {
+		if v != nil {
+			parameters[k] = *v
+		}
+	}
+	if err := d.Set("parameters", parameters); err != nil {
+		return fmt.Errorf("setting `parameters`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmDataFactoryPipelineRunDelete(_ *schema.ResourceData, _ interface{}) error {
+	// there's no "undo" for a Pipeline Run that's already completed - removing this resource
+	// just forgets about it, matching how other action-style resources in this provider behave.
+	return nil
+}
+
+func dataFactoryPipelineRunStateRefreshFunc(ctx context.Context, client *datafactory.PipelineRunsClient, resourceGroup, factoryName, runId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		run, err := client.Get(ctx, resourceGroup, factoryName, runId)
+		if err != nil {
+			return nil, "", fmt.Errorf("polling for the status of Pipeline Run %q (Data Factory %q / Resource Group %q): %+v", runId, factoryName, resourceGroup, err)
+		}
+
+		status := ""
+		if run.Status != nil {
+			status = *run.Status
+		}
+
+		if status == "Failed" {
+			return run, status, fmt.Errorf("Pipeline Run %q (Data Factory %q / Resource Group %q) failed", runId, factoryName, resourceGroup)
+		}
+
+		return run, status, nil
+	}
+}
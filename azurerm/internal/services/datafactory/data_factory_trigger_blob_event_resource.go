@@ -0,0 +1,338 @@
+package datafactory
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmDataFactoryTriggerBlobEvent() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDataFactoryTriggerBlobEventCreateUpdate,
+		Read:   resourceArmDataFactoryTriggerBlobEventRead,
+		Update: resourceArmDataFactoryTriggerBlobEventCreateUpdate,
+		Delete: resourceArmDataFactoryTriggerBlobEventDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DataFactoryPipelineAndTriggerName(),
+			},
+
+			// There's a bug in the Azure API where this is returned in lower-case
+			// BUG: https://github.com/Azure/azure-rest-api-specs/issues/5788
+			"resource_group_name": azure.SchemaResourceGroupNameDiffSuppress(),
+
+			"data_factory_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DataFactoryName(),
+			},
+
+			"storage_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"events": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(datafactory.MicrosoftStorageBlobCreated),
+						string(datafactory.MicrosoftStorageBlobDeleted),
+					}, false),
+				},
+			},
+
+			"subject_begins_with": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"subject_ends_with": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"ignore_empty_blobs": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"pipeline_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.DataFactoryPipelineAndTriggerName(),
+			},
+
+			"pipeline_parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"annotations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"activated": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceArmDataFactoryTriggerBlobEventCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.TriggersClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Data Factory Trigger Blob Event creation.")
+
+	resourceGroupName := d.Get("resource_group_name").(string)
+	triggerName := d.Get("name").(string)
+	dataFactoryName := d.Get("data_factory_name").(string)
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroupName, dataFactoryName, triggerName, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Data Factory Trigger Blob Event %q (Resource Group %q / Data Factory %q): %s", triggerName, resourceGroupName, dataFactoryName, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_data_factory_trigger_blob_event", *existing.ID)
+		}
+	}
+
+	eventsRaw := d.Get("events").(*schema.Set).List()
+	events := make([]datafactory.BlobEventTypes, len(eventsRaw))
+	for i, event := range eventsRaw {
+		events[i] = datafactory.BlobEventTypes(event.(string))
+	}
+
+	props := &datafactory.BlobEventsTriggerTypeProperties{
+		Scope:            utils.String(d.Get("storage_account_id").(string)),
+		Events:           &events,
+		IgnoreEmptyBlobs: utils.Bool(d.Get("ignore_empty_blobs").(bool)),
+	}
+
+	if v, ok := d.GetOk("subject_begins_with"); ok {
+		props.BlobPathBeginsWith = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("subject_ends_with"); ok {
+		props.BlobPathEndsWith = utils.String(v.(string))
+	}
+
+	reference := &datafactory.PipelineReference{
+		ReferenceName: utils.String(d.Get("pipeline_name").(string)),
+		Type:          utils.String("PipelineReference"),
+	}
+
+	blobEventsTrigger := &datafactory.BlobEventsTrigger{
+		BlobEventsTriggerTypeProperties: props,
+		Pipelines: &[]datafactory.TriggerPipelineReference{
+			{
+				PipelineReference: reference,
+				Parameters:        d.Get("pipeline_parameters").(map[string]interface{}),
+			},
+		},
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		blobEventsTrigger.Description = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("annotations"); ok {
+		annotations := v.([]interface{})
+		blobEventsTrigger.Annotations = &annotations
+	}
+
+	trigger := datafactory.TriggerResource{
+		Properties: blobEventsTrigger,
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroupName, dataFactoryName, triggerName, trigger, ""); err != nil {
+		return fmt.Errorf("Error creating Data Factory Trigger Blob Event %q (Resource Group %q / Data Factory %q): %+v", triggerName, resourceGroupName, dataFactoryName, err)
+	}
+
+	if d.Get("activated").(bool) {
+		future, err := client.Start(ctx, resourceGroupName, dataFactoryName, triggerName)
+		if err != nil {
+			return fmt.Errorf("Error starting Data Factory Trigger Blob Event %q (Resource Group %q / Data Factory %q): %+v", triggerName, resourceGroupName, dataFactoryName, err)
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for start of Data Factory Trigger Blob Event %q (Resource Group %q / Data Factory %q): %+v", triggerName, resourceGroupName, dataFactoryName, err)
+		}
+	} else {
+		future, err := client.Stop(ctx, resourceGroupName, dataFactoryName, triggerName)
+		if err != nil {
+			return fmt.Errorf("Error stopping Data Factory Trigger Blob Event %q (Resource Group %q / Data Factory %q): %+v", triggerName, resourceGroupName, dataFactoryName, err)
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for stop of Data Factory Trigger Blob Event %q (Resource Group %q / Data Factory %q): %+v", triggerName, resourceGroupName, dataFactoryName, err)
+		}
+	}
+
+	read, err := client.Get(ctx, resourceGroupName, dataFactoryName, triggerName, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Data Factory Trigger Blob Event %q (Resource Group %q / Data Factory %q): %+v", triggerName, resourceGroupName, dataFactoryName, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Data Factory Trigger Blob Event %q (Resource Group %q / Data Factory %q) ID", triggerName, resourceGroupName, dataFactoryName)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmDataFactoryTriggerBlobEventRead(d, meta)
+}
+
+func resourceArmDataFactoryTriggerBlobEventRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.TriggersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	dataFactoryName := id.Path["factories"]
+	triggerName := id.Path["triggers"]
+
+	resp, err := client.Get(ctx, id.ResourceGroup, dataFactoryName, triggerName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			log.Printf("[DEBUG] Data Factory Trigger Blob Event %q was not found in Resource Group %q - removing from state!", triggerName, id.ResourceGroup)
+			return nil
+		}
+		return fmt.Errorf("Error reading the state of Data Factory Trigger Blob Event %q: %+v", triggerName, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("data_factory_name", dataFactoryName)
+
+	blobEventsTriggerProps, ok := resp.Properties.AsBlobEventsTrigger()
+	if !ok {
+		return fmt.Errorf("Error classifiying Data Factory Trigger Blob Event %q (Data Factory %q / Resource Group %q): Expected: %q Received: %q", triggerName, dataFactoryName, id.ResourceGroup, datafactory.TypeBlobEventsTrigger, *resp.Type)
+	}
+
+	d.Set("activated", blobEventsTriggerProps.RuntimeState == datafactory.TriggerRuntimeStateStarted)
+
+	if blobEventsTriggerProps.Description != nil {
+		d.Set("description", blobEventsTriggerProps.Description)
+	}
+
+	if props := blobEventsTriggerProps.BlobEventsTriggerTypeProperties; props != nil {
+		if props.Scope != nil {
+			d.Set("storage_account_id", props.Scope)
+		}
+
+		d.Set("subject_begins_with", props.BlobPathBeginsWith)
+		d.Set("subject_ends_with", props.BlobPathEndsWith)
+
+		ignoreEmptyBlobs := false
+		if props.IgnoreEmptyBlobs != nil {
+			ignoreEmptyBlobs = *props.IgnoreEmptyBlobs
+		}
+		d.Set("ignore_empty_blobs", ignoreEmptyBlobs)
+
+		events := make([]interface{}, 0)
+		if props.Events != nil {
+			for _, event := range *props.Events {
+				events = append(events, string(event))
+			}
+		}
+		d.Set("events", events)
+	}
+
+	if pipelines := blobEventsTriggerProps.Pipelines; pipelines != nil && len(*pipelines) > 0 {
+		pipeline := *pipelines
+		if reference := pipeline[0].PipelineReference; reference != nil {
+			d.Set("pipeline_name", reference.ReferenceName)
+		}
+		d.Set("pipeline_parameters", pipeline[0].Parameters)
+	}
+
+	annotations := flattenDataFactoryAnnotations(blobEventsTriggerProps.Annotations)
+	if err := d.Set("annotations", annotations); err != nil {
+		return fmt.Errorf("Error setting `annotations`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmDataFactoryTriggerBlobEventDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.TriggersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	dataFactoryName := id.Path["factories"]
+	triggerName := id.Path["triggers"]
+
+	stopFuture, err := client.Stop(ctx, id.ResourceGroup, dataFactoryName, triggerName)
+	if err != nil {
+		return fmt.Errorf("Error stopping Data Factory Trigger Blob Event %q (Resource Group %q / Data Factory %q): %+v", triggerName, id.ResourceGroup, dataFactoryName, err)
+	}
+	if err := stopFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for stop of Data Factory Trigger Blob Event %q (Resource Group %q / Data Factory %q): %+v", triggerName, id.ResourceGroup, dataFactoryName, err)
+	}
+
+	if _, err = client.Delete(ctx, id.ResourceGroup, dataFactoryName, triggerName); err != nil {
+		return fmt.Errorf("Error deleting Data Factory Trigger Blob Event %q (Resource Group %q / Data Factory %q): %+v", triggerName, id.ResourceGroup, dataFactoryName, err)
+	}
+
+	return nil
+}
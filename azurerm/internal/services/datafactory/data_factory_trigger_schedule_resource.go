@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/set"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
@@ -117,6 +118,127 @@ func resourceArmDataFactoryTriggerSchedule() *schema.Resource {
 					ValidateFunc: validation.StringIsNotEmpty,
 				},
 			},
+
+			"schedule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"minutes": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validation.IntBetween(0, 59),
+							},
+							Set: set.HashInt,
+						},
+
+						"hours": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validation.IntBetween(0, 23),
+							},
+							Set: set.HashInt,
+						},
+
+						"days_of_week": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(datafactory.DaysOfWeekMonday),
+									string(datafactory.DaysOfWeekTuesday),
+									string(datafactory.DaysOfWeekWednesday),
+									string(datafactory.DaysOfWeekThursday),
+									string(datafactory.DaysOfWeekFriday),
+									string(datafactory.DaysOfWeekSaturday),
+									string(datafactory.DaysOfWeekSunday),
+								}, false),
+							},
+							Set: set.HashStringIgnoreCase,
+						},
+
+						"days_of_month": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+								ValidateFunc: validation.All(
+									validation.IntBetween(-1, 31),
+									validation.IntNotInSlice([]int{0}),
+								),
+							},
+							Set: set.HashInt,
+						},
+
+						"monthly": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"weekday": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(datafactory.Monday),
+											string(datafactory.Tuesday),
+											string(datafactory.Wednesday),
+											string(datafactory.Thursday),
+											string(datafactory.Friday),
+											string(datafactory.Saturday),
+											string(datafactory.Sunday),
+										}, false),
+									},
+
+									// `-1` means the last occurrence of `weekday` in the month, matching the API's own convention.
+									"week": {
+										Type:     schema.TypeInt,
+										Required: true,
+										ValidateFunc: validation.All(
+											validation.IntBetween(-1, 5),
+											validation.IntNotInSlice([]int{0}),
+										),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			frequency := diff.Get("frequency").(string)
+
+			scheduleRaw, hasSchedule := diff.GetOk("schedule")
+			if !hasSchedule {
+				return nil
+			}
+
+			if frequency != string(datafactory.Day) && frequency != string(datafactory.Week) && frequency != string(datafactory.Month) {
+				return fmt.Errorf("`schedule` can only be set when `frequency` is `Day`, `Week` or `Month`")
+			}
+
+			schedule := scheduleRaw.([]interface{})[0].(map[string]interface{})
+
+			if v := schedule["days_of_week"].(*schema.Set).List(); len(v) > 0 && frequency != string(datafactory.Week) {
+				return fmt.Errorf("`schedule.0.days_of_week` can only be set when `frequency` is `Week`")
+			}
+
+			if v := schedule["days_of_month"].(*schema.Set).List(); len(v) > 0 && frequency != string(datafactory.Month) {
+				return fmt.Errorf("`schedule.0.days_of_month` can only be set when `frequency` is `Month`")
+			}
+
+			if v := schedule["monthly"].([]interface{}); len(v) > 0 && frequency != string(datafactory.Month) {
+				return fmt.Errorf("`schedule.0.monthly` can only be set when `frequency` is `Month`")
+			}
+
+			return nil
 		},
 	}
 }
@@ -164,6 +286,10 @@ func resourceArmDataFactoryTriggerScheduleCreateUpdate(d *schema.ResourceData, m
 		props.Recurrence.EndTime = &date.Time{Time: t}
 	}
 
+	if v, ok := d.GetOk("schedule"); ok {
+		props.Recurrence.Schedule = expandDataFactoryTriggerScheduleRecurrenceSchedule(v.([]interface{}))
+	}
+
 	reference := &datafactory.PipelineReference{
 		ReferenceName: utils.String(d.Get("pipeline_name").(string)),
 		Type:          utils.String("PipelineReference"),
@@ -247,6 +373,10 @@ func resourceArmDataFactoryTriggerScheduleRead(d *schema.ResourceData, meta inte
 			}
 			d.Set("frequency", recurrence.Frequency)
 			d.Set("interval", recurrence.Interval)
+
+			if err := d.Set("schedule", flattenDataFactoryTriggerScheduleRecurrenceSchedule(recurrence.Schedule)); err != nil {
+				return fmt.Errorf("Error setting `schedule`: %+v", err)
+			}
 		}
 
 		if pipelines := scheduleTriggerProps.Pipelines; pipelines != nil {
@@ -286,3 +416,120 @@ func resourceArmDataFactoryTriggerScheduleDelete(d *schema.ResourceData, meta in
 
 	return nil
 }
+
+func expandDataFactoryTriggerScheduleRecurrenceSchedule(input []interface{}) *datafactory.RecurrenceSchedule {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	schedule := input[0].(map[string]interface{})
+	recurrenceSchedule := &datafactory.RecurrenceSchedule{}
+
+	if v := schedule["minutes"].(*schema.Set).List(); len(v) > 0 {
+		minutes := make([]int32, len(v))
+		for i, minute := range v {
+			minutes[i] = int32(minute.(int))
+		}
+		recurrenceSchedule.Minutes = &minutes
+	}
+
+	if v := schedule["hours"].(*schema.Set).List(); len(v) > 0 {
+		hours := make([]int32, len(v))
+		for i, hour := range v {
+			hours[i] = int32(hour.(int))
+		}
+		recurrenceSchedule.Hours = &hours
+	}
+
+	if v := schedule["days_of_week"].(*schema.Set).List(); len(v) > 0 {
+		daysOfWeek := make([]datafactory.DaysOfWeek, len(v))
+		for i, day := range v {
+			daysOfWeek[i] = datafactory.DaysOfWeek(day.(string))
+		}
+		recurrenceSchedule.WeekDays = &daysOfWeek
+	}
+
+	if v := schedule["days_of_month"].(*schema.Set).List(); len(v) > 0 {
+		daysOfMonth := make([]int32, len(v))
+		for i, day := range v {
+			daysOfMonth[i] = int32(day.(int))
+		}
+		recurrenceSchedule.MonthDays = &daysOfMonth
+	}
+
+	if v := schedule["monthly"].([]interface{}); len(v) > 0 {
+		monthlyOccurrences := make([]datafactory.RecurrenceScheduleOccurrence, len(v))
+		for i, occurrenceRaw := range v {
+			occurrence := occurrenceRaw.(map[string]interface{})
+			monthlyOccurrences[i] = datafactory.RecurrenceScheduleOccurrence{
+				Day:        datafactory.DayOfWeek(occurrence["weekday"].(string)),
+				Occurrence: utils.Int32(int32(occurrence["week"].(int))),
+			}
+		}
+		recurrenceSchedule.MonthlyOccurrences = &monthlyOccurrences
+	}
+
+	return recurrenceSchedule
+}
+
+func flattenDataFactoryTriggerScheduleRecurrenceSchedule(input *datafactory.RecurrenceSchedule) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	minutes := schema.NewSet(set.HashInt, []interface{}{})
+	if input.Minutes != nil {
+		for _, minute := range *input.Minutes {
+			minutes.Add(int(minute))
+		}
+	}
+
+	hours := schema.NewSet(set.HashInt, []interface{}{})
+	if input.Hours != nil {
+		for _, hour := range *input.Hours {
+			hours.Add(int(hour))
+		}
+	}
+
+	daysOfWeek := schema.NewSet(set.HashStringIgnoreCase, []interface{}{})
+	if input.WeekDays != nil {
+		for _, day := range *input.WeekDays {
+			daysOfWeek.Add(string(day))
+		}
+	}
+
+	daysOfMonth := schema.NewSet(set.HashInt, []interface{}{})
+	if input.MonthDays != nil {
+		for _, day := range *input.MonthDays {
+			daysOfMonth.Add(int(day))
+		}
+	}
+
+	monthly := make([]interface{}, 0)
+	if input.MonthlyOccurrences != nil {
+		for _, occurrence := range *input.MonthlyOccurrences {
+			week := 0
+			if occurrence.Occurrence != nil {
+				week = int(*occurrence.Occurrence)
+			}
+			monthly = append(monthly, map[string]interface{}{
+				"weekday": string(occurrence.Day),
+				"week":    week,
+			})
+		}
+	}
+
+	if minutes.Len() == 0 && hours.Len() == 0 && daysOfWeek.Len() == 0 && daysOfMonth.Len() == 0 && len(monthly) == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"minutes":       minutes,
+			"hours":         hours,
+			"days_of_week":  daysOfWeek,
+			"days_of_month": daysOfMonth,
+			"monthly":       monthly,
+		},
+	}
+}
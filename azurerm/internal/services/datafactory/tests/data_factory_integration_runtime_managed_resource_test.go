@@ -99,6 +99,59 @@ func TestAccAzureRMDataFactoryIntegrationRuntimeManaged_customSetupScript(t *tes
 	})
 }
 
+func TestAccAzureRMDataFactoryIntegrationRuntimeManaged_expressCustomSetup(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_integration_runtime_managed", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMDataFactoryIntegrationRuntimeManagedDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDataFactoryIntegrationRuntimeManaged_expressCustomSetup(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDataFactoryIntegrationRuntimeManagedExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "express_custom_setup.#", "1"),
+					resource.TestCheckResourceAttr(data.ResourceName, "express_custom_setup.0.component.#", "1"),
+					resource.TestCheckResourceAttr(data.ResourceName, "express_custom_setup.0.component.0.name", "SSDT"),
+					resource.TestCheckResourceAttr(data.ResourceName, "express_custom_setup.0.component.0.license_key", "my-license-key"),
+					resource.TestCheckResourceAttr(data.ResourceName, "express_custom_setup.0.environment_variable.%", "1"),
+					resource.TestCheckResourceAttr(data.ResourceName, "express_custom_setup.0.command_key.#", "1"),
+					resource.TestCheckResourceAttr(data.ResourceName, "express_custom_setup.0.command_key.0.target_name", "AzureBlobFS"),
+					resource.TestCheckResourceAttr(data.ResourceName, "express_custom_setup.0.command_key.0.user_name", "accountName"),
+					resource.TestCheckResourceAttr(data.ResourceName, "express_custom_setup.0.command_key.0.password", "accountKey"),
+				),
+			},
+			data.ImportStep(
+				"express_custom_setup.0.component.0.license_key",
+				"express_custom_setup.0.command_key.0.password",
+			),
+		},
+	})
+}
+
+func TestAccAzureRMDataFactoryIntegrationRuntimeManaged_packageStore(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_integration_runtime_managed", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMDataFactoryIntegrationRuntimeManagedDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDataFactoryIntegrationRuntimeManaged_packageStore(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDataFactoryIntegrationRuntimeManagedExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "package_store.#", "1"),
+					resource.TestCheckResourceAttr(data.ResourceName, "package_store.0.name", "acctestpackagestore"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "package_store.0.linked_service_name"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
 func testAccAzureRMDataFactoryIntegrationRuntimeManaged_basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -290,6 +343,97 @@ resource "azurerm_data_factory_integration_runtime_managed" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomString)
 }
 
+func testAccAzureRMDataFactoryIntegrationRuntimeManaged_expressCustomSetup(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdfirm%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_data_factory_integration_runtime_managed" "test" {
+  name                = "managed-integration-runtime"
+  data_factory_name   = azurerm_data_factory.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  node_size = "Standard_D8_v3"
+
+  express_custom_setup {
+    component {
+      name        = "SSDT"
+      license_key = "my-license-key"
+    }
+
+    environment_variable = {
+      TEST_ENV_VARIABLE = "test-value"
+    }
+
+    command_key {
+      target_name = "AzureBlobFS"
+      user_name   = "accountName"
+      password    = "accountKey"
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func testAccAzureRMDataFactoryIntegrationRuntimeManaged_packageStore(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdfirm%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+data "azurerm_client_config" "current" {
+}
+
+resource "azurerm_data_factory_linked_service_data_lake_storage_gen2" "test" {
+  name                  = "acctestDataLake%d"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  data_factory_name     = "${azurerm_data_factory.test.name}"
+  service_principal_id  = data.azurerm_client_config.current.client_id
+  service_principal_key = "testkey"
+  tenant                = "11111111-1111-1111-1111-111111111111"
+  url                   = "https://test.azure.com"
+}
+
+resource "azurerm_data_factory_integration_runtime_managed" "test" {
+  name                = "managed-integration-runtime"
+  data_factory_name   = azurerm_data_factory.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  node_size = "Standard_D8_v3"
+
+  package_store {
+    name                 = "acctestpackagestore"
+    linked_service_name  = "${azurerm_data_factory_linked_service_data_lake_storage_gen2.test.name}"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
+
 func testCheckAzureRMDataFactoryIntegrationRuntimeManagedExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client := acceptance.AzureProvider.Meta().(*clients.Client).DataFactory.IntegrationRuntimesClient
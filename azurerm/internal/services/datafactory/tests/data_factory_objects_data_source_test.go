@@ -0,0 +1,42 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+)
+
+func TestAccAzureRMDataFactoryObjectsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_data_factory_objects", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMDataFactoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDataFactoryObjectsDataSource_basic(data),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(data.ResourceName, "pipelines.%"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "datasets.%"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAzureRMDataFactoryObjectsDataSource_basic(data acceptance.TestData) string {
+	config := testAccAzureRMDataFactoryPipeline_basic(data)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_data_factory_objects" "test" {
+  name                = azurerm_data_factory.test.name
+  resource_group_name = azurerm_data_factory.test.resource_group_name
+
+  depends_on = [azurerm_data_factory_pipeline.test]
+}
+`, config)
+}
@@ -0,0 +1,160 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMDataFactoryPipelineRun_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_pipeline_run", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMDataFactoryPipelineRunDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDataFactoryPipelineRun_basic(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDataFactoryPipelineRunExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "status", "Succeeded"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "run_id"),
+				),
+			},
+			data.ImportStep("run_trigger", "wait_for_completion"),
+		},
+	})
+}
+
+func TestAccAzureRMDataFactoryPipelineRun_parameters(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_pipeline_run", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMDataFactoryPipelineRunDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDataFactoryPipelineRun_parameters(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDataFactoryPipelineRunExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "parameters.%", "1"),
+					resource.TestCheckResourceAttr(data.ResourceName, "parameters.input", "hello"),
+				),
+			},
+			data.ImportStep("run_trigger", "wait_for_completion"),
+		},
+	})
+}
+
+func testCheckAzureRMDataFactoryPipelineRunExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.AzureProvider.Meta().(*clients.Client).DataFactory.PipelineRunsClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		dataFactoryId, err := azure.ParseAzureResourceID(rs.Primary.Attributes["data_factory_id"])
+		if err != nil {
+			return err
+		}
+		resourceGroup := dataFactoryId.ResourceGroup
+		factoryName := dataFactoryId.Path["factories"]
+		runId := rs.Primary.Attributes["run_id"]
+
+		resp, err := client.Get(ctx, resourceGroup, factoryName, runId)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on PipelineRunsClient: %+v", err)
+		}
+
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Bad: Data Factory Pipeline Run %q does not exist", runId)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMDataFactoryPipelineRunDestroy(_ *terraform.State) error {
+	// Pipeline Runs are an immutable record of a past execution - destroying this resource only
+	// forgets about it in Terraform state, it isn't deleted from Data Factory, so there's nothing
+	// to assert here beyond `terraform destroy` succeeding.
+	return nil
+}
+
+func testAccAzureRMDataFactoryPipelineRun_basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdf%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_data_factory_pipeline" "test" {
+  name                = "acctest%d"
+  resource_group_name = azurerm_resource_group.test.name
+  data_factory_name   = azurerm_data_factory.test.name
+}
+
+resource "azurerm_data_factory_pipeline_run" "test" {
+  data_factory_id = azurerm_data_factory.test.id
+  pipeline_name   = azurerm_data_factory_pipeline.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
+
+func testAccAzureRMDataFactoryPipelineRun_parameters(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdf%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_data_factory_pipeline" "test" {
+  name                = "acctest%d"
+  resource_group_name = azurerm_resource_group.test.name
+  data_factory_name   = azurerm_data_factory.test.name
+  parameters = {
+    input = "default"
+  }
+}
+
+resource "azurerm_data_factory_pipeline_run" "test" {
+  data_factory_id = azurerm_data_factory.test.id
+  pipeline_name   = azurerm_data_factory_pipeline.test.name
+
+  parameters = {
+    input = "hello"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
@@ -0,0 +1,263 @@
+package dns
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/dns/parse"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// resourceArmDnsZoneDelegation creates the NS Record Set in a parent DNS Zone which delegates a
+// subdomain to a child DNS Zone, so the child zone's Name Servers don't need to be copied across
+// manually every time the child zone is recreated.
+func resourceArmDnsZoneDelegation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDnsZoneDelegationCreateUpdate,
+		Read:   resourceArmDnsZoneDelegationRead,
+		Update: resourceArmDnsZoneDelegationCreateUpdate,
+		Delete: resourceArmDnsZoneDelegationDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.DnsNsRecordID(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"parent_zone_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDnsZoneDelegationZoneID,
+			},
+
+			"child_zone_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDnsZoneDelegationZoneID,
+			},
+
+			// Populated automatically by reading `child_zone_id`'s Name Servers - unless the child
+			// zone is in a different Subscription than this provider is configured against, in which
+			// case its Name Servers must be supplied here directly.
+			"name_servers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"ttl": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  3600,
+			},
+
+			"fqdn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmDnsZoneDelegationCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	zonesClient := meta.(*clients.Client).Dns.ZonesClient
+	recordSetsClient := meta.(*clients.Client).Dns.RecordSetsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	parentZoneID, err := parse.DnsZoneID(d.Get("parent_zone_id").(string))
+	if err != nil {
+		return err
+	}
+
+	childZoneID, err := parse.DnsZoneID(d.Get("child_zone_id").(string))
+	if err != nil {
+		return err
+	}
+
+	parentZone, err := zonesClient.Get(ctx, parentZoneID.ResourceGroup, parentZoneID.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving parent DNS Zone %q (Resource Group %q): %+v", parentZoneID.Name, parentZoneID.ResourceGroup, err)
+	}
+	if parentZone.Name == nil {
+		return fmt.Errorf("retrieving parent DNS Zone %q (Resource Group %q): `name` was nil", parentZoneID.Name, parentZoneID.ResourceGroup)
+	}
+
+	relativeName, err := dnsZoneDelegationRelativeRecordName(childZoneID.Name, *parentZone.Name)
+	if err != nil {
+		return err
+	}
+
+	nameServersRaw := d.Get("name_servers").([]interface{})
+	nameServers := expandDnsZoneDelegationNameServers(nameServersRaw)
+	if len(nameServers) == 0 {
+		childZone, err := zonesClient.Get(ctx, childZoneID.ResourceGroup, childZoneID.Name)
+		if err != nil {
+			return fmt.Errorf("retrieving child DNS Zone %q (Resource Group %q): %+v - if the child zone is in a different Subscription than this provider is configured against, specify `name_servers` directly instead", childZoneID.Name, childZoneID.ResourceGroup, err)
+		}
+		if childZone.NameServers == nil {
+			return fmt.Errorf("child DNS Zone %q (Resource Group %q) has no Name Servers assigned yet", childZoneID.Name, childZoneID.ResourceGroup)
+		}
+		nameServers = *childZone.NameServers
+	}
+
+	if d.IsNewResource() && features.ShouldResourcesBeImported() {
+		existing, err := recordSetsClient.Get(ctx, parentZoneID.ResourceGroup, parentZoneID.Name, relativeName, dns.NS)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for existing DNS Zone Delegation %q (Parent Zone %q / Resource Group %q): %+v", relativeName, parentZoneID.Name, parentZoneID.ResourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_dns_zone_delegation", *existing.ID)
+		}
+	}
+
+	ttl := int64(d.Get("ttl").(int))
+	parameters := dns.RecordSet{
+		Name: &relativeName,
+		RecordSetProperties: &dns.RecordSetProperties{
+			TTL:       &ttl,
+			NsRecords: expandDnsZoneDelegationNsRecords(nameServers),
+		},
+	}
+
+	eTag := ""
+	ifNoneMatch := "" // set to empty to allow updates to records after creation
+	if _, err := recordSetsClient.CreateOrUpdate(ctx, parentZoneID.ResourceGroup, parentZoneID.Name, relativeName, dns.NS, parameters, eTag, ifNoneMatch); err != nil {
+		return fmt.Errorf("creating/updating DNS Zone Delegation %q (Parent Zone %q / Resource Group %q): %+v", relativeName, parentZoneID.Name, parentZoneID.ResourceGroup, err)
+	}
+
+	resp, err := recordSetsClient.Get(ctx, parentZoneID.ResourceGroup, parentZoneID.Name, relativeName, dns.NS)
+	if err != nil {
+		return fmt.Errorf("retrieving DNS Zone Delegation %q (Parent Zone %q / Resource Group %q): %+v", relativeName, parentZoneID.Name, parentZoneID.ResourceGroup, err)
+	}
+
+	if resp.ID == nil || *resp.ID == "" {
+		return fmt.Errorf("retrieving DNS Zone Delegation %q (Parent Zone %q / Resource Group %q): ID was empty", relativeName, parentZoneID.Name, parentZoneID.ResourceGroup)
+	}
+	d.SetId(*resp.ID)
+
+	return resourceArmDnsZoneDelegationRead(d, meta)
+}
+
+func resourceArmDnsZoneDelegationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Dns.RecordSetsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DnsNsRecordID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ZoneName, id.Name, dns.NS)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("reading DNS Zone Delegation %q (Zone %q / Resource Group %q): %+v", id.Name, id.ZoneName, id.ResourceGroup, err)
+	}
+
+	// `child_zone_id` isn't a property of the NS Record Set itself - it's only used at apply-time to
+	// look up `name_servers`, so it can't be recovered from the Azure API and is left unmodified here.
+
+	// `parent_zone_id` is the DNS Zone holding this NS Record Set, i.e. the Record Set's ID minus its `/NS/{name}` suffix.
+	d.Set("parent_zone_id", strings.TrimSuffix(d.Id(), "/NS/"+id.Name))
+	d.Set("ttl", resp.TTL)
+	d.Set("fqdn", resp.Fqdn)
+
+	if props := resp.RecordSetProperties; props != nil {
+		if err := d.Set("name_servers", flattenAzureRmDnsNsRecords(props.NsRecords)); err != nil {
+			return fmt.Errorf("setting `name_servers`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmDnsZoneDelegationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Dns.RecordSetsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DnsNsRecordID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Delete(ctx, id.ResourceGroup, id.ZoneName, id.Name, dns.NS, "")
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("deleting DNS Zone Delegation %q (Zone %q / Resource Group %q): %+v", id.Name, id.ZoneName, id.ResourceGroup, err)
+	}
+
+	return nil
+}
+
+func validateDnsZoneDelegationZoneID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := parse.DnsZoneID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid DNS Zone ID: %+v", k, err))
+	}
+
+	return
+}
+
+func expandDnsZoneDelegationNameServers(input []interface{}) []string {
+	nameServers := make([]string, 0, len(input))
+	for _, v := range input {
+		nameServers = append(nameServers, v.(string))
+	}
+	return nameServers
+}
+
+func expandDnsZoneDelegationNsRecords(nameServers []string) *[]dns.NsRecord {
+	records := make([]dns.NsRecord, 0, len(nameServers))
+	for _, nameServer := range nameServers {
+		nameServer := nameServer
+		records = append(records, dns.NsRecord{Nsdname: &nameServer})
+	}
+	return &records
+}
+
+// dnsZoneDelegationRelativeRecordName works out the relative NS Record name to create in the parent
+// zone - e.g. delegating `child.example.com` from `example.com` creates a record named `child`.
+func dnsZoneDelegationRelativeRecordName(childZoneName string, parentZoneName string) (string, error) {
+	suffix := "." + parentZoneName
+	if !strings.HasSuffix(childZoneName, suffix) {
+		return "", fmt.Errorf("child DNS Zone %q is not a subdomain of parent DNS Zone %q", childZoneName, parentZoneName)
+	}
+
+	return strings.TrimSuffix(childZoneName, suffix), nil
+}
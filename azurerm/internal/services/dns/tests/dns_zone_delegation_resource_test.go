@@ -0,0 +1,172 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/dns/parse"
+)
+
+func TestAccAzureRMDnsZoneDelegation_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_zone_delegation", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMDnsZoneDelegationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDnsZoneDelegation_basic(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDnsZoneDelegationExists(data.ResourceName),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "fqdn"),
+					resource.TestCheckResourceAttr(data.ResourceName, "name_servers.#", "2"),
+				),
+			},
+			data.ImportStep("child_zone_id"),
+		},
+	})
+}
+
+func TestAccAzureRMDnsZoneDelegation_explicitNameServers(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_zone_delegation", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMDnsZoneDelegationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDnsZoneDelegation_explicitNameServers(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDnsZoneDelegationExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "name_servers.#", "2"),
+					resource.TestCheckResourceAttr(data.ResourceName, "name_servers.0", "ns1.contoso.com"),
+				),
+			},
+			data.ImportStep("child_zone_id"),
+		},
+	})
+}
+
+func testCheckAzureRMDnsZoneDelegationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acceptance.AzureProvider.Meta().(*clients.Client).Dns.RecordSetsClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parse.DnsNsRecordID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := conn.Get(ctx, id.ResourceGroup, id.ZoneName, id.Name, dns.NS)
+		if err != nil {
+			return fmt.Errorf("Bad: Get DNS Zone Delegation: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: DNS Zone Delegation %s (resource group: %s) does not exist", id.Name, id.ResourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMDnsZoneDelegationDestroy(s *terraform.State) error {
+	conn := acceptance.AzureProvider.Meta().(*clients.Client).Dns.RecordSetsClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_dns_zone_delegation" {
+			continue
+		}
+
+		id, err := parse.DnsNsRecordID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := conn.Get(ctx, id.ResourceGroup, id.ZoneName, id.Name, dns.NS)
+
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("DNS Zone Delegation still exists:\n%#v", resp.RecordSetProperties)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMDnsZoneDelegation_basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dns_zone" "parent" {
+  name                = "acctestparent%d.com"
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_dns_zone" "child" {
+  name                = "child.acctestparent%d.com"
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_dns_zone_delegation" "test" {
+  parent_zone_id = azurerm_dns_zone.parent.id
+  child_zone_id  = azurerm_dns_zone.child.id
+  ttl            = 300
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
+
+func testAccAzureRMDnsZoneDelegation_explicitNameServers(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dns_zone" "parent" {
+  name                = "acctestparent%d.com"
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_dns_zone" "child" {
+  name                = "child.acctestparent%d.com"
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_dns_zone_delegation" "test" {
+  parent_zone_id = azurerm_dns_zone.parent.id
+  child_zone_id  = azurerm_dns_zone.child.id
+  ttl            = 300
+
+  name_servers = ["ns1.contoso.com", "ns2.contoso.com"]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
@@ -4,9 +4,13 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
+	"reflect"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/hdinsight/mgmt/2018-06-01-preview/hdinsight"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
@@ -35,13 +39,36 @@ func hdinsightClusterUpdate(clusterKind string, readFunc schema.ReadFunc) schema
 			params := hdinsight.ClusterPatchParameters{
 				Tags: tags.Expand(t),
 			}
-			if _, err := client.Update(ctx, resourceGroup, name, params); err != nil {
+
+			// the Patch endpoint used here only ever touches Tags, so it's safe to retry on its own - but the
+			// vendored HDInsight API doesn't support an If-Match/etag precondition on this call, so a Patch that
+			// races with a concurrent Resize can still return a 409 Conflict rather than being serialized by the
+			// API itself. Retry those until the other operation clears rather than surfacing a spurious failure.
+			if err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+				if _, err := client.Update(ctx, resourceGroup, name, params); err != nil {
+					if utils.ResponseErrorIsRetryable(err) {
+						return resource.RetryableError(err)
+					}
+					if detailedErr, ok := err.(autorest.DetailedError); ok {
+						if statusCode, ok := detailedErr.StatusCode.(int); ok && statusCode == http.StatusConflict {
+							return resource.RetryableError(err)
+						}
+					}
+					return resource.NonRetryableError(err)
+				}
+				return nil
+			}); err != nil {
 				return fmt.Errorf("Error updating Tags for HDInsight %q Cluster %q (Resource Group %q): %+v", clusterKind, name, resourceGroup, err)
 			}
 		}
 
 		if d.HasChange("roles.0.worker_node") {
 			log.Printf("[DEBUG] Resizing the HDInsight %q Cluster", clusterKind)
+
+			// For an HBase cluster this Resize call can fail with an opaque error if it scales worker nodes down
+			// before the cluster's region servers have finished draining - that drain status only lives on the
+			// cluster's own HBase Master REST endpoint, not on this vendored HDInsight Resource Manager client,
+			// so it can't be checked or waited on here.
 			rolesRaw := d.Get("roles").([]interface{})
 			roles := rolesRaw[0].(map[string]interface{})
 			workerNodes := roles["worker_node"].([]interface{})
@@ -61,47 +88,63 @@ func hdinsightClusterUpdate(clusterKind string, readFunc schema.ReadFunc) schema
 			}
 		}
 
-		// The API can add an edge node but can't remove them without force newing the resource. We'll check for adding here
-		// and can come back to removing if that functionality gets added. https://feedback.azure.com/forums/217335-hdinsight/suggestions/5663773-start-stop-cluster-hdinsight?page=3&per_page=20
+		// The API doesn't support updating an edge node in-place, so any edge node that's been added, removed or
+		// changed is deleted and recreated under its own name - since each is its own named Application, this
+		// doesn't affect any of the cluster's other edge nodes.
 		if clusterKind == "Hadoop" {
 			if d.HasChange("roles.0.edge_node") {
 				log.Printf("[DEBUG] Detected change in edge nodes")
-				edgeNodeRaw := d.Get("roles.0.edge_node").([]interface{})
-				edgeNodeConfig := edgeNodeRaw[0].(map[string]interface{})
 				applicationsClient := meta.(*clients.Client).HDInsight.ApplicationsClient
 
-				oldEdgeNodeCount, newEdgeNodeCount := d.GetChange("roles.0.edge_node.0.target_instance_count")
-				oldEdgeNodeInt := oldEdgeNodeCount.(int)
-				newEdgeNodeInt := newEdgeNodeCount.(int)
+				oldRaw, newRaw := d.GetChange("roles.0.edge_node")
+				oldEdgeNodes := oldRaw.([]interface{})
+				newEdgeNodes := newRaw.([]interface{})
+
+				oldEdgeNodesByName := make(map[string]map[string]interface{})
+				for _, v := range oldEdgeNodes {
+					edgeNode := v.(map[string]interface{})
+					oldEdgeNodesByName[edgeNode["name"].(string)] = edgeNode
+				}
+
+				newEdgeNodesByName := make(map[string]map[string]interface{})
+				for _, v := range newEdgeNodes {
+					edgeNode := v.(map[string]interface{})
+					newEdgeNodesByName[edgeNode["name"].(string)] = edgeNode
+				}
+
+				for edgeNodeName, oldEdgeNode := range oldEdgeNodesByName {
+					newEdgeNode, stillExists := newEdgeNodesByName[edgeNodeName]
+					if stillExists && reflect.DeepEqual(oldEdgeNode, newEdgeNode) {
+						continue
+					}
 
-				// Note: API currently doesn't support updating number of edge nodes
-				// if anything in the edge nodes changes, delete edge nodes then recreate them
-				if oldEdgeNodeInt != 0 {
-					err := deleteHDInsightEdgeNodes(ctx, applicationsClient, resourceGroup, name)
-					if err != nil {
+					if err := deleteHDInsightEdgeNodes(ctx, applicationsClient, resourceGroup, name, edgeNodeName); err != nil {
 						return err
 					}
 				}
 
-				if newEdgeNodeInt != 0 {
-					err = createHDInsightEdgeNodes(ctx, applicationsClient, resourceGroup, name, edgeNodeConfig)
-					if err != nil {
+				for edgeNodeName, newEdgeNode := range newEdgeNodesByName {
+					if oldEdgeNode, existed := oldEdgeNodesByName[edgeNodeName]; existed && reflect.DeepEqual(oldEdgeNode, newEdgeNode) {
+						continue
+					}
+
+					if err := createHDInsightEdgeNodes(ctx, applicationsClient, resourceGroup, name, edgeNodeName, newEdgeNode); err != nil {
 						return err
 					}
-				}
 
-				// we can't rely on the use of the Future here due to the node being successfully completed but now the cluster is applying those changes.
-				log.Printf("[DEBUG] Waiting for Hadoop Cluster to %q (Resource Group %q) to finish applying edge node", name, resourceGroup)
-				stateConf := &resource.StateChangeConf{
-					Pending:    []string{"AzureVMConfiguration", "Accepted", "HdInsightConfiguration"},
-					Target:     []string{"Running"},
-					Refresh:    hdInsightWaitForReadyRefreshFunc(ctx, client, resourceGroup, name),
-					MinTimeout: 15 * time.Second,
-					Timeout:    d.Timeout(schema.TimeoutUpdate),
-				}
+					// we can't rely on the use of the Future here due to the node being successfully completed but now the cluster is applying those changes.
+					log.Printf("[DEBUG] Waiting for Hadoop Cluster %q (Resource Group %q) to finish applying edge node %q", name, resourceGroup, edgeNodeName)
+					stateConf := &resource.StateChangeConf{
+						Pending:    []string{"AzureVMConfiguration", "Accepted", "HdInsightConfiguration"},
+						Target:     []string{"Running"},
+						Refresh:    hdInsightWaitForReadyRefreshFunc(ctx, client, resourceGroup, name),
+						MinTimeout: 15 * time.Second,
+						Timeout:    d.Timeout(schema.TimeoutUpdate),
+					}
 
-				if _, err := stateConf.WaitForState(); err != nil {
-					return fmt.Errorf("Error waiting for HDInsight Cluster %q (Resource Group %q) to be running: %s", name, resourceGroup, err)
+					if _, err := stateConf.WaitForState(); err != nil {
+						return fmt.Errorf("Error waiting for HDInsight Cluster %q (Resource Group %q) to be running: %s", name, resourceGroup, err)
+					}
 				}
 			}
 		}
@@ -229,7 +272,7 @@ func flattenHDInsightRoles(d *schema.ResourceData, input *hdinsight.ComputeProfi
 	}
 }
 
-func createHDInsightEdgeNodes(ctx context.Context, client *hdinsight.ApplicationsClient, resourceGroup string, name string, input map[string]interface{}) error {
+func createHDInsightEdgeNodes(ctx context.Context, client *hdinsight.ApplicationsClient, resourceGroup string, clusterName string, edgeNodeName string, input map[string]interface{}) error {
 	installScriptActions := expandHDInsightApplicationEdgeNodeInstallScriptActions(input["install_script_action"].([]interface{}))
 
 	application := hdinsight.Application{
@@ -247,27 +290,27 @@ func createHDInsightEdgeNodes(ctx context.Context, client *hdinsight.Application
 			ApplicationType:      utils.String("CustomApplication"),
 		},
 	}
-	future, err := client.Create(ctx, resourceGroup, name, name, application)
+	future, err := client.Create(ctx, resourceGroup, clusterName, edgeNodeName, application)
 	if err != nil {
-		return fmt.Errorf("Error creating edge nodes for HDInsight Hadoop Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error creating edge node %q for HDInsight Hadoop Cluster %q (Resource Group %q): %+v", edgeNodeName, clusterName, resourceGroup, err)
 	}
 
 	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for creation of edge node for HDInsight Hadoop Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error waiting for creation of edge node %q for HDInsight Hadoop Cluster %q (Resource Group %q): %+v", edgeNodeName, clusterName, resourceGroup, err)
 	}
 
 	return nil
 }
 
-func deleteHDInsightEdgeNodes(ctx context.Context, client *hdinsight.ApplicationsClient, resourceGroup string, name string) error {
-	future, err := client.Delete(ctx, resourceGroup, name, name)
+func deleteHDInsightEdgeNodes(ctx context.Context, client *hdinsight.ApplicationsClient, resourceGroup string, clusterName string, edgeNodeName string) error {
+	future, err := client.Delete(ctx, resourceGroup, clusterName, edgeNodeName)
 
 	if err != nil {
-		return fmt.Errorf("Error deleting edge nodes for HDInsight Hadoop Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error deleting edge node %q for HDInsight Hadoop Cluster %q (Resource Group %q): %+v", edgeNodeName, clusterName, resourceGroup, err)
 	}
 
 	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for deletion of edge nodes for HDInsight Hadoop Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error waiting for deletion of edge node %q for HDInsight Hadoop Cluster %q (Resource Group %q): %+v", edgeNodeName, clusterName, resourceGroup, err)
 	}
 
 	return nil
@@ -299,6 +342,40 @@ func expandHDInsightsMetastore(input []interface{}) map[string]interface{} {
 	return config
 }
 
+// schemaHDInsightsCustomConfigurations exposes a flat map of dotted `<category>.<property>` keys (e.g.
+// `yarn-site.yarn.log-aggregation-enable`) rather than a genuine two-level map-of-maps, since the `helper/schema`
+// SDK version this provider is built against only allows a TypeMap's Elem to be a single primitive Schema.
+func schemaHDInsightsCustomConfigurations() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Optional: true,
+		ForceNew: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+}
+
+// expandHDInsightsCustomConfigurations splits each dotted `configurations` key on its first `.` and merges the
+// resulting category/property pairs into an existing Ambari configurations map, so custom config isn't limited
+// to the fixed set of categories (`gateway`, `hive-site`, ...) this provider already knows how to build.
+func expandHDInsightsCustomConfigurations(input map[string]interface{}, configurations map[string]interface{}) error {
+	for k, v := range input {
+		parts := strings.SplitN(k, ".", 2)
+		if len(parts) != 2 {
+			return fmt.Errorf("`configurations` key %q is invalid: must be of the form `<category>.<property>`, e.g. `yarn-site.yarn.log-aggregation-enable`", k)
+		}
+		category, property := parts[0], parts[1]
+
+		categoryMap, ok := configurations[category].(map[string]interface{})
+		if !ok {
+			categoryMap = map[string]interface{}{}
+		}
+		categoryMap[property] = v.(string)
+		configurations[category] = categoryMap
+	}
+
+	return nil
+}
+
 func flattenHDInsightsMetastores(d *schema.ResourceData, configurations map[string]map[string]*string) {
 	result := map[string]interface{}{}
 
@@ -0,0 +1,141 @@
+package hdinsight
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/hdinsight/mgmt/2018-06-01-preview/hdinsight"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+)
+
+func dataSourceArmHDInsightCapabilities() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmHDInsightCapabilitiesRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"location": azure.SchemaLocation(),
+
+			"cluster_versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kind": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"versions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"vm_sizes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sizes": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"features": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceArmHDInsightCapabilitiesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).HDInsight.LocationsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	location := azure.NormalizeLocation(d.Get("location").(string))
+
+	resp, err := client.GetCapabilities(ctx, location)
+	if err != nil {
+		return fmt.Errorf("Error retrieving HDInsight Capabilities for Location %q: %+v", location, err)
+	}
+
+	d.SetId(fmt.Sprintf("hdinsightCapabilities|%s", location))
+
+	if err := d.Set("cluster_versions", flattenHDInsightCapabilitiesClusterVersions(resp.Versions)); err != nil {
+		return fmt.Errorf("Error setting `cluster_versions`: %+v", err)
+	}
+
+	if err := d.Set("vm_sizes", flattenHDInsightCapabilitiesVMSizes(resp.VMSizes)); err != nil {
+		return fmt.Errorf("Error setting `vm_sizes`: %+v", err)
+	}
+
+	features := make([]string, 0)
+	if resp.Features != nil {
+		features = *resp.Features
+	}
+	d.Set("features", features)
+
+	return nil
+}
+
+func flattenHDInsightCapabilitiesClusterVersions(input map[string]*hdinsight.VersionsCapability) []interface{} {
+	output := make([]interface{}, 0)
+
+	for kind, capability := range input {
+		if capability == nil || capability.Available == nil {
+			continue
+		}
+
+		versions := make([]string, 0)
+		for _, v := range *capability.Available {
+			if v.FriendlyName == nil {
+				continue
+			}
+			versions = append(versions, *v.FriendlyName)
+		}
+
+		output = append(output, map[string]interface{}{
+			"kind":     kind,
+			"versions": versions,
+		})
+	}
+
+	return output
+}
+
+func flattenHDInsightCapabilitiesVMSizes(input map[string]*hdinsight.VMSizesCapability) []interface{} {
+	output := make([]interface{}, 0)
+
+	for role, capability := range input {
+		if capability == nil || capability.Available == nil {
+			continue
+		}
+
+		output = append(output, map[string]interface{}{
+			"role":  role,
+			"sizes": *capability.Available,
+		})
+	}
+
+	return output
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/hdinsight/mgmt/2018-06-01-preview/hdinsight"
@@ -87,12 +88,21 @@ func resourceArmHDInsightHadoopCluster() *schema.Resource {
 							Required: true,
 							ForceNew: true,
 						},
+
+						"other_versions": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
 					},
 				},
 			},
 
 			"gateway": azure.SchemaHDInsightsGateway(),
 
+			"security_profile": azure.SchemaHDInsightsSecurityProfile(),
+
 			"metastores": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -112,6 +122,8 @@ func resourceArmHDInsightHadoopCluster() *schema.Resource {
 
 			"storage_account_gen2": azure.SchemaHDInsightsGen2StorageAccounts(),
 
+			"configurations": schemaHDInsightsCustomConfigurations(),
+
 			"roles": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -127,9 +139,14 @@ func resourceArmHDInsightHadoopCluster() *schema.Resource {
 						"edge_node": {
 							Type:     schema.TypeList,
 							Optional: true,
-							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
 									"target_instance_count": {
 										Type:         schema.TypeInt,
 										Required:     true,
@@ -210,6 +227,12 @@ func resourceArmHDInsightHadoopClusterCreate(d *schema.ResourceData, meta interf
 		}
 	}
 
+	if customConfigurationsRaw, ok := d.GetOk("configurations"); ok {
+		if err := expandHDInsightsCustomConfigurations(customConfigurationsRaw.(map[string]interface{}), configurations); err != nil {
+			return fmt.Errorf("Error expanding `configurations`: %+v", err)
+		}
+	}
+
 	storageAccountsRaw := d.Get("storage_account").([]interface{})
 	storageAccountsGen2Raw := d.Get("storage_account_gen2").([]interface{})
 	storageAccounts, identity, err := azure.ExpandHDInsightsStorageAccounts(storageAccountsRaw, storageAccountsGen2Raw)
@@ -259,6 +282,7 @@ func resourceArmHDInsightHadoopClusterCreate(d *schema.ResourceData, meta interf
 			ComputeProfile: &hdinsight.ComputeProfile{
 				Roles: roles,
 			},
+			SecurityProfile: azure.ExpandHDInsightsSecurityProfile(d.Get("security_profile").([]interface{})),
 		},
 		Tags:     tags.Expand(t),
 		Identity: identity,
@@ -283,29 +307,32 @@ func resourceArmHDInsightHadoopClusterCreate(d *schema.ResourceData, meta interf
 
 	d.SetId(*read.ID)
 
-	// We can only add an edge node after creation
+	// We can only add edge nodes after creation
 	if v, ok := d.GetOk("roles.0.edge_node"); ok {
-		edgeNodeRaw := v.([]interface{})
+		edgeNodesRaw := v.([]interface{})
 		applicationsClient := meta.(*clients.Client).HDInsight.ApplicationsClient
-		edgeNodeConfig := edgeNodeRaw[0].(map[string]interface{})
 
-		err := createHDInsightEdgeNodes(ctx, applicationsClient, resourceGroup, name, edgeNodeConfig)
-		if err != nil {
-			return err
-		}
+		for _, edgeNodeRaw := range edgeNodesRaw {
+			edgeNodeConfig := edgeNodeRaw.(map[string]interface{})
+			edgeNodeName := edgeNodeConfig["name"].(string)
 
-		// we can't rely on the use of the Future here due to the node being successfully completed but now the cluster is applying those changes.
-		log.Printf("[DEBUG] Waiting for Hadoop Cluster to %q (Resource Group %q) to finish applying edge node", name, resourceGroup)
-		stateConf := &resource.StateChangeConf{
-			Pending:    []string{"AzureVMConfiguration", "Accepted", "HdInsightConfiguration"},
-			Target:     []string{"Running"},
-			Refresh:    hdInsightWaitForReadyRefreshFunc(ctx, client, resourceGroup, name),
-			MinTimeout: 15 * time.Second,
-			Timeout:    d.Timeout(schema.TimeoutCreate),
-		}
+			if err := createHDInsightEdgeNodes(ctx, applicationsClient, resourceGroup, name, edgeNodeName, edgeNodeConfig); err != nil {
+				return err
+			}
+
+			// we can't rely on the use of the Future here due to the node being successfully completed but now the cluster is applying those changes.
+			log.Printf("[DEBUG] Waiting for Hadoop Cluster %q (Resource Group %q) to finish applying edge node %q", name, resourceGroup, edgeNodeName)
+			stateConf := &resource.StateChangeConf{
+				Pending:    []string{"AzureVMConfiguration", "Accepted", "HdInsightConfiguration"},
+				Target:     []string{"Running"},
+				Refresh:    hdInsightWaitForReadyRefreshFunc(ctx, client, resourceGroup, name),
+				MinTimeout: 15 * time.Second,
+				Timeout:    d.Timeout(schema.TimeoutCreate),
+			}
 
-		if _, err := stateConf.WaitForState(); err != nil {
-			return fmt.Errorf("Error waiting for HDInsight Cluster %q (Resource Group %q) to be running: %s", name, resourceGroup, err)
+			if _, err := stateConf.WaitForState(); err != nil {
+				return fmt.Errorf("Error waiting for HDInsight Cluster %q (Resource Group %q) to be running: %s", name, resourceGroup, err)
+			}
 		}
 	}
 
@@ -372,6 +399,10 @@ func resourceArmHDInsightHadoopClusterRead(d *schema.ResourceData, meta interfac
 			flattenHDInsightsMetastores(d, configurations.Configurations)
 		}
 
+		if err := d.Set("security_profile", azure.FlattenHDInsightsSecurityProfile(props.SecurityProfile, d)); err != nil {
+			return fmt.Errorf("Error flattening `security_profile`: %+v", err)
+		}
+
 		hadoopRoles := hdInsightRoleDefinition{
 			HeadNodeDef:      hdInsightHadoopClusterHeadNodeDefinition,
 			WorkerNodeDef:    hdInsightHadoopClusterWorkerNodeDefinition,
@@ -381,17 +412,22 @@ func resourceArmHDInsightHadoopClusterRead(d *schema.ResourceData, meta interfac
 
 		applicationsClient := meta.(*clients.Client).HDInsight.ApplicationsClient
 
-		edgeNode, err := applicationsClient.Get(ctx, resourceGroup, name, name)
+		applications, err := applicationsClient.ListByCluster(ctx, resourceGroup, name)
 		if err != nil {
-			if !utils.ResponseWasNotFound(edgeNode.Response) {
-				return fmt.Errorf("Error reading edge node for HDInsight Hadoop Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
-			}
+			return fmt.Errorf("Error listing edge nodes for HDInsight Hadoop Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
 		}
 
-		if edgeNodeProps := edgeNode.Properties; edgeNodeProps != nil {
-			flattenedRoles = flattenHDInsightEdgeNode(flattenedRoles, edgeNodeProps)
+		edgeNodes := make([]interface{}, 0)
+		for _, application := range applications.Values() {
+			if application.Name == nil || application.Properties == nil {
+				continue
+			}
+
+			edgeNodes = append(edgeNodes, flattenHDInsightEdgeNode(*application.Name, application.Properties))
 		}
 
+		flattenedRoles[0].(map[string]interface{})["edge_node"] = edgeNodes
+
 		if err := d.Set("roles", flattenedRoles); err != nil {
 			return fmt.Errorf("Error flattening `roles`: %+v", err)
 		}
@@ -405,14 +441,11 @@ func resourceArmHDInsightHadoopClusterRead(d *schema.ResourceData, meta interfac
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
-func flattenHDInsightEdgeNode(roles []interface{}, props *hdinsight.ApplicationProperties) []interface{} {
-	if len(roles) == 0 || props == nil {
-		return roles
+func flattenHDInsightEdgeNode(name string, props *hdinsight.ApplicationProperties) map[string]interface{} {
+	edgeNode := map[string]interface{}{
+		"name": name,
 	}
 
-	role := roles[0].(map[string]interface{})
-
-	edgeNode := make(map[string]interface{})
 	if computeProfile := props.ComputeProfile; computeProfile != nil {
 		if roles := computeProfile.Roles; roles != nil {
 			for _, role := range *roles {
@@ -436,28 +469,37 @@ func flattenHDInsightEdgeNode(roles []interface{}, props *hdinsight.ApplicationP
 
 	edgeNode["install_script_action"] = []interface{}{actions}
 
-	role["edge_node"] = []interface{}{edgeNode}
-
-	return []interface{}{role}
+	return edgeNode
 }
 
 func expandHDInsightHadoopComponentVersion(input []interface{}) map[string]*string {
 	vs := input[0].(map[string]interface{})
-	return map[string]*string{
+	versions := map[string]*string{
 		"Hadoop": utils.String(vs["hadoop"].(string)),
 	}
+	for name, version := range vs["other_versions"].(map[string]interface{}) {
+		versions[name] = utils.String(version.(string))
+	}
+	return versions
 }
 
 func flattenHDInsightHadoopComponentVersion(input map[string]*string) []interface{} {
 	hadoopVersion := ""
-	if v, ok := input["Hadoop"]; ok {
-		if v != nil {
-			hadoopVersion = *v
+	otherVersions := make(map[string]interface{})
+	for name, version := range input {
+		if version == nil {
+			continue
+		}
+		if strings.EqualFold(name, "Hadoop") {
+			hadoopVersion = *version
+			continue
 		}
+		otherVersions[name] = *version
 	}
 	return []interface{}{
 		map[string]interface{}{
-			"hadoop": hadoopVersion,
+			"hadoop":         hadoopVersion,
+			"other_versions": otherVersions,
 		},
 	}
 }
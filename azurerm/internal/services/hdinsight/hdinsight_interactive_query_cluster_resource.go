@@ -3,6 +3,7 @@ package hdinsight
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/hdinsight/mgmt/2018-06-01-preview/hdinsight"
@@ -82,6 +83,13 @@ func resourceArmHDInsightInteractiveQueryCluster() *schema.Resource {
 							Required: true,
 							ForceNew: true,
 						},
+
+						"other_versions": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
 					},
 				},
 			},
@@ -92,6 +100,8 @@ func resourceArmHDInsightInteractiveQueryCluster() *schema.Resource {
 
 			"storage_account_gen2": azure.SchemaHDInsightsGen2StorageAccounts(),
 
+			"configurations": schemaHDInsightsCustomConfigurations(),
+
 			"roles": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -141,6 +151,12 @@ func resourceArmHDInsightInteractiveQueryClusterCreate(d *schema.ResourceData, m
 	gatewayRaw := d.Get("gateway").([]interface{})
 	gateway := azure.ExpandHDInsightsConfigurations(gatewayRaw)
 
+	if customConfigurationsRaw, ok := d.GetOk("configurations"); ok {
+		if err := expandHDInsightsCustomConfigurations(customConfigurationsRaw.(map[string]interface{}), gateway); err != nil {
+			return fmt.Errorf("Error expanding `configurations`: %+v", err)
+		}
+	}
+
 	storageAccountsRaw := d.Get("storage_account").([]interface{})
 	storageAccountsGen2Raw := d.Get("storage_account_gen2").([]interface{})
 	storageAccounts, identity, err := azure.ExpandHDInsightsStorageAccounts(storageAccountsRaw, storageAccountsGen2Raw)
@@ -290,21 +306,32 @@ func resourceArmHDInsightInteractiveQueryClusterRead(d *schema.ResourceData, met
 
 func expandHDInsightInteractiveQueryComponentVersion(input []interface{}) map[string]*string {
 	vs := input[0].(map[string]interface{})
-	return map[string]*string{
+	versions := map[string]*string{
 		"InteractiveHive": utils.String(vs["interactive_hive"].(string)),
 	}
+	for name, version := range vs["other_versions"].(map[string]interface{}) {
+		versions[name] = utils.String(version.(string))
+	}
+	return versions
 }
 
 func flattenHDInsightInteractiveQueryComponentVersion(input map[string]*string) []interface{} {
 	interactiveHiveVersion := ""
-	if v, ok := input["InteractiveHive"]; ok {
-		if v != nil {
-			interactiveHiveVersion = *v
+	otherVersions := make(map[string]interface{})
+	for name, version := range input {
+		if version == nil {
+			continue
+		}
+		if strings.EqualFold(name, "InteractiveHive") {
+			interactiveHiveVersion = *version
+			continue
 		}
+		otherVersions[name] = *version
 	}
 	return []interface{}{
 		map[string]interface{}{
 			"interactive_hive": interactiveHiveVersion,
+			"other_versions":   otherVersions,
 		},
 	}
 }
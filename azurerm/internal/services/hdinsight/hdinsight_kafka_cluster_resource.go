@@ -3,6 +3,7 @@ package hdinsight
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/hdinsight/mgmt/2018-06-01-preview/hdinsight"
@@ -83,6 +84,13 @@ func resourceArmHDInsightKafkaCluster() *schema.Resource {
 							Required: true,
 							ForceNew: true,
 						},
+
+						"other_versions": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
 					},
 				},
 			},
@@ -93,6 +101,8 @@ func resourceArmHDInsightKafkaCluster() *schema.Resource {
 
 			"storage_account_gen2": azure.SchemaHDInsightsGen2StorageAccounts(),
 
+			"configurations": schemaHDInsightsCustomConfigurations(),
+
 			"roles": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -142,6 +152,12 @@ func resourceArmHDInsightKafkaClusterCreate(d *schema.ResourceData, meta interfa
 	gatewayRaw := d.Get("gateway").([]interface{})
 	gateway := azure.ExpandHDInsightsConfigurations(gatewayRaw)
 
+	if customConfigurationsRaw, ok := d.GetOk("configurations"); ok {
+		if err := expandHDInsightsCustomConfigurations(customConfigurationsRaw.(map[string]interface{}), gateway); err != nil {
+			return fmt.Errorf("Error expanding `configurations`: %+v", err)
+		}
+	}
+
 	storageAccountsRaw := d.Get("storage_account").([]interface{})
 	storageAccountsGen2Raw := d.Get("storage_account_gen2").([]interface{})
 	storageAccounts, identity, err := azure.ExpandHDInsightsStorageAccounts(storageAccountsRaw, storageAccountsGen2Raw)
@@ -291,21 +307,32 @@ func resourceArmHDInsightKafkaClusterRead(d *schema.ResourceData, meta interface
 
 func expandHDInsightKafkaComponentVersion(input []interface{}) map[string]*string {
 	vs := input[0].(map[string]interface{})
-	return map[string]*string{
+	versions := map[string]*string{
 		"kafka": utils.String(vs["kafka"].(string)),
 	}
+	for name, version := range vs["other_versions"].(map[string]interface{}) {
+		versions[name] = utils.String(version.(string))
+	}
+	return versions
 }
 
 func flattenHDInsightKafkaComponentVersion(input map[string]*string) []interface{} {
 	kafkaVersion := ""
-	if v, ok := input["kafka"]; ok {
-		if v != nil {
-			kafkaVersion = *v
+	otherVersions := make(map[string]interface{})
+	for name, version := range input {
+		if version == nil {
+			continue
+		}
+		if strings.EqualFold(name, "kafka") {
+			kafkaVersion = *version
+			continue
 		}
+		otherVersions[name] = *version
 	}
 	return []interface{}{
 		map[string]interface{}{
-			"kafka": kafkaVersion,
+			"kafka":          kafkaVersion,
+			"other_versions": otherVersions,
 		},
 	}
 }
@@ -92,6 +92,8 @@ func resourceArmHDInsightRServerCluster() *schema.Resource {
 
 			"storage_account": azure.SchemaHDInsightsStorageAccounts(),
 
+			"configurations": schemaHDInsightsCustomConfigurations(),
+
 			"roles": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -156,6 +158,12 @@ func resourceArmHDInsightRServerClusterCreate(d *schema.ResourceData, meta inter
 	rStudio := d.Get("rstudio").(bool)
 	gateway := expandHDInsightsRServerConfigurations(gatewayRaw, rStudio)
 
+	if customConfigurationsRaw, ok := d.GetOk("configurations"); ok {
+		if err := expandHDInsightsCustomConfigurations(customConfigurationsRaw.(map[string]interface{}), gateway); err != nil {
+			return fmt.Errorf("Error expanding `configurations`: %+v", err)
+		}
+	}
+
 	storageAccountsRaw := d.Get("storage_account").([]interface{})
 	storageAccounts, identity, err := azure.ExpandHDInsightsStorageAccounts(storageAccountsRaw, nil)
 	if err != nil {
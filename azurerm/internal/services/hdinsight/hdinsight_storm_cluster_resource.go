@@ -3,6 +3,7 @@ package hdinsight
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/hdinsight/mgmt/2018-06-01-preview/hdinsight"
@@ -83,6 +84,13 @@ func resourceArmHDInsightStormCluster() *schema.Resource {
 							Required: true,
 							ForceNew: true,
 						},
+
+						"other_versions": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
 					},
 				},
 			},
@@ -91,6 +99,8 @@ func resourceArmHDInsightStormCluster() *schema.Resource {
 
 			"storage_account": azure.SchemaHDInsightsStorageAccounts(),
 
+			"configurations": schemaHDInsightsCustomConfigurations(),
+
 			"roles": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -140,6 +150,12 @@ func resourceArmHDInsightStormClusterCreate(d *schema.ResourceData, meta interfa
 	gatewayRaw := d.Get("gateway").([]interface{})
 	gateway := azure.ExpandHDInsightsConfigurations(gatewayRaw)
 
+	if customConfigurationsRaw, ok := d.GetOk("configurations"); ok {
+		if err := expandHDInsightsCustomConfigurations(customConfigurationsRaw.(map[string]interface{}), gateway); err != nil {
+			return fmt.Errorf("Error expanding `configurations`: %+v", err)
+		}
+	}
+
 	storageAccountsRaw := d.Get("storage_account").([]interface{})
 	storageAccounts, identity, err := azure.ExpandHDInsightsStorageAccounts(storageAccountsRaw, nil)
 	if err != nil {
@@ -288,21 +304,32 @@ func resourceArmHDInsightStormClusterRead(d *schema.ResourceData, meta interface
 
 func expandHDInsightStormComponentVersion(input []interface{}) map[string]*string {
 	vs := input[0].(map[string]interface{})
-	return map[string]*string{
+	versions := map[string]*string{
 		"Storm": utils.String(vs["storm"].(string)),
 	}
+	for name, version := range vs["other_versions"].(map[string]interface{}) {
+		versions[name] = utils.String(version.(string))
+	}
+	return versions
 }
 
 func flattenHDInsightStormComponentVersion(input map[string]*string) []interface{} {
 	stormVersion := ""
-	if v, ok := input["Storm"]; ok {
-		if v != nil {
-			stormVersion = *v
+	otherVersions := make(map[string]interface{})
+	for name, version := range input {
+		if version == nil {
+			continue
+		}
+		if strings.EqualFold(name, "Storm") {
+			stormVersion = *version
+			continue
 		}
+		otherVersions[name] = *version
 	}
 	return []interface{}{
 		map[string]interface{}{
-			"storm": stormVersion,
+			"storm":          stormVersion,
+			"other_versions": otherVersions,
 		},
 	}
 }
@@ -21,7 +21,8 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
-		"azurerm_hdinsight_cluster": dataSourceArmHDInsightSparkCluster(),
+		"azurerm_hdinsight_cluster":      dataSourceArmHDInsightSparkCluster(),
+		"azurerm_hdinsight_capabilities": dataSourceArmHDInsightCapabilities(),
 	}
 }
 
@@ -0,0 +1,34 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+)
+
+func TestAccDataSourceAzureRMHDInsightCapabilities_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_hdinsight_capabilities", "test")
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceHDInsightCapabilities_basic(data),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(data.ResourceName, "cluster_versions.#"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "vm_sizes.#"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "features.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceHDInsightCapabilities_basic(data acceptance.TestData) string {
+	return `
+data "azurerm_hdinsight_capabilities" "test" {
+  location = "West Europe"
+}
+`
+}
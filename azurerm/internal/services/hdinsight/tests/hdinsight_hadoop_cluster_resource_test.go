@@ -526,6 +526,7 @@ resource "azurerm_hdinsight_hadoop_cluster" "import" {
       dynamic "edge_node" {
         for_each = lookup(roles.value, "edge_node", [])
         content {
+          name                   = edge_node.value.name
           target_instance_count = edge_node.value.target_instance_count
           vm_size               = edge_node.value.vm_size
 
@@ -887,8 +888,9 @@ resource "azurerm_hdinsight_hadoop_cluster" "test" {
     }
 
     edge_node {
+      name                   = "edge1"
       target_instance_count = %d
-      vm_size               = "%s"
+      vm_size                = "%s"
       install_script_action {
         name = "script1"
         uri  = "https://raw.githubusercontent.com/Azure/azure-quickstart-templates/master/101-hdinsight-linux-with-edge-node/scripts/EmptyNodeSetup.sh"
@@ -309,7 +309,29 @@ func resourceArmKeyVaultCertificate() *schema.Resource {
 				},
 			},
 
+			"merge_signed_certificate": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"certificate_data": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "The PEM-encoded signed certificate (or certificate chain) returned by the external CA in response to the `certificate_signing_request`, used to complete a pending certificate created with an `issuer_parameters.name` of `Unknown`.",
+						},
+					},
+				},
+			},
+
 			// Computed
+			"certificate_signing_request": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"version": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -379,6 +401,8 @@ func resourceArmKeyVaultCertificateCreate(d *schema.ResourceData, meta interface
 		}
 	} else {
 		// Generate new
+		mergeSignedCertificateRaw := d.Get("merge_signed_certificate").([]interface{})
+
 		parameters := keyvault.CertificateCreateParameters{
 			CertificatePolicy: &policy,
 			Tags:              tags.Expand(t),
@@ -411,11 +435,34 @@ func resourceArmKeyVaultCertificateCreate(d *schema.ResourceData, meta interface
 			}
 		}
 
+		if len(mergeSignedCertificateRaw) > 0 {
+			log.Printf("[DEBUG] Waiting for Certificate Signing Request for Key Vault Certificate %q in Vault %q to be issued", name, keyVaultBaseUrl)
+			csrStateConf := &resource.StateChangeConf{
+				Pending:    []string{"Pending"},
+				Target:     []string{"Ready"},
+				Refresh:    keyVaultCertificatePendingCsrRefreshFunc(ctx, client, keyVaultBaseUrl, name),
+				MinTimeout: 15 * time.Second,
+				Timeout:    d.Timeout(schema.TimeoutCreate),
+			}
+			if _, err := csrStateConf.WaitForState(); err != nil {
+				return fmt.Errorf("Error waiting for Certificate Signing Request for %q in Vault %q to be issued: %s", name, keyVaultBaseUrl, err)
+			}
+
+			mergeRaw := mergeSignedCertificateRaw[0].(map[string]interface{})
+			signedCertificate := []byte(mergeRaw["certificate_data"].(string))
+			mergeParameters := keyvault.CertificateMergeParameters{
+				X509Certificates: &[][]byte{signedCertificate},
+			}
+			if _, err := client.MergeCertificate(ctx, keyVaultBaseUrl, name, mergeParameters); err != nil {
+				return fmt.Errorf("Error merging signed Certificate into pending Certificate %q in Vault %q: %s", name, keyVaultBaseUrl, err)
+			}
+		}
+
 		log.Printf("[DEBUG] Waiting for Key Vault Certificate %q in Vault %q to be provisioned", name, keyVaultBaseUrl)
 		stateConf := &resource.StateChangeConf{
 			Pending:    []string{"Provisioning"},
 			Target:     []string{"Ready"},
-			Refresh:    keyVaultCertificateCreationRefreshFunc(ctx, client, keyVaultBaseUrl, name),
+			Refresh:    keyVaultCertificateCreationRefreshFunc(ctx, client, keyVaultBaseUrl, name, len(mergeSignedCertificateRaw) > 0),
 			MinTimeout: 15 * time.Second,
 			Timeout:    d.Timeout(schema.TimeoutCreate),
 		}
@@ -435,14 +482,15 @@ func resourceArmKeyVaultCertificateCreate(d *schema.ResourceData, meta interface
 	return resourceArmKeyVaultCertificateRead(d, meta)
 }
 
-func keyVaultCertificateCreationRefreshFunc(ctx context.Context, client *keyvault.BaseClient, keyVaultBaseUrl string, name string) resource.StateRefreshFunc {
+func keyVaultCertificateCreationRefreshFunc(ctx context.Context, client *keyvault.BaseClient, keyVaultBaseUrl string, name string, waitForMerge bool) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		res, err := client.GetCertificate(ctx, keyVaultBaseUrl, name, "")
 		if err != nil {
 			return nil, "", fmt.Errorf("Error issuing read request in keyVaultCertificateCreationRefreshFunc for Certificate %q in Vault %q: %s", name, keyVaultBaseUrl, err)
 		}
 
-		if res.Policy != nil &&
+		if !waitForMerge &&
+			res.Policy != nil &&
 			res.Policy.IssuerParameters != nil &&
 			res.Policy.IssuerParameters.Name != nil &&
 			strings.EqualFold(*(res.Policy.IssuerParameters.Name), "unknown") {
@@ -457,6 +505,24 @@ func keyVaultCertificateCreationRefreshFunc(ctx context.Context, client *keyvaul
 	}
 }
 
+// keyVaultCertificatePendingCsrRefreshFunc waits for the Certificate Signing Request to be
+// generated for a pending Certificate Operation (an `issuer_parameters.name` of `Unknown`), so it
+// can be merged with a signed certificate obtained from an external CA.
+func keyVaultCertificatePendingCsrRefreshFunc(ctx context.Context, client *keyvault.BaseClient, keyVaultBaseUrl string, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		res, err := client.GetCertificateOperation(ctx, keyVaultBaseUrl, name)
+		if err != nil {
+			return nil, "", fmt.Errorf("Error issuing read request in keyVaultCertificatePendingCsrRefreshFunc for Certificate %q in Vault %q: %s", name, keyVaultBaseUrl, err)
+		}
+
+		if res.Csr == nil || len(*res.Csr) == 0 {
+			return nil, "Pending", nil
+		}
+
+		return res, "Ready", nil
+	}
+}
+
 func resourceArmKeyVaultCertificateRead(d *schema.ResourceData, meta interface{}) error {
 	keyVaultClient := meta.(*clients.Client).KeyVault.VaultsClient
 	client := meta.(*clients.Client).KeyVault.ManagementClient
@@ -506,6 +572,16 @@ func resourceArmKeyVaultCertificateRead(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("Error setting Key Vault Certificate Policy: %+v", err)
 	}
 
+	certificateSigningRequest := ""
+	if operation, err := client.GetCertificateOperation(ctx, id.KeyVaultBaseUrl, id.Name); err == nil {
+		if operation.Csr != nil {
+			certificateSigningRequest = base64.StdEncoding.EncodeToString(*operation.Csr)
+		}
+	} else if !utils.ResponseWasNotFound(operation.Response) {
+		return fmt.Errorf("Error retrieving Certificate Operation for Certificate %q in Vault %q: %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+	d.Set("certificate_signing_request", certificateSigningRequest)
+
 	// Computed
 	d.Set("version", id.Version)
 	d.Set("secret_id", cert.Sid)
@@ -6,12 +6,16 @@ import (
 )
 
 type Client struct {
-	ClustersClient        *kusto.ClustersClient
-	DatabasesClient       *kusto.DatabasesClient
-	DataConnectionsClient *kusto.DataConnectionsClient
+	AttachedDatabaseConfigurationsClient *kusto.AttachedDatabaseConfigurationsClient
+	ClustersClient                       *kusto.ClustersClient
+	DatabasesClient                      *kusto.DatabasesClient
+	DataConnectionsClient                *kusto.DataConnectionsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
+	AttachedDatabaseConfigurationsClient := kusto.NewAttachedDatabaseConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&AttachedDatabaseConfigurationsClient.Client, o.ResourceManagerAuthorizer)
+
 	ClustersClient := kusto.NewClustersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ClustersClient.Client, o.ResourceManagerAuthorizer)
 
@@ -22,8 +26,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&DataConnectionsClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		ClustersClient:        &ClustersClient,
-		DatabasesClient:       &DatabasesClient,
-		DataConnectionsClient: &DataConnectionsClient,
+		AttachedDatabaseConfigurationsClient: &AttachedDatabaseConfigurationsClient,
+		ClustersClient:                       &ClustersClient,
+		DatabasesClient:                      &DatabasesClient,
+		DataConnectionsClient:                &DataConnectionsClient,
 	}
 }
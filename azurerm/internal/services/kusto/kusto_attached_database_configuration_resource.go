@@ -0,0 +1,215 @@
+package kusto
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/kusto/mgmt/2020-02-15/kusto"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/kusto/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmKustoAttachedDatabaseConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmKustoAttachedDatabaseConfigurationCreateUpdate,
+		Read:   resourceArmKustoAttachedDatabaseConfigurationRead,
+		Update: resourceArmKustoAttachedDatabaseConfigurationCreateUpdate,
+		Delete: resourceArmKustoAttachedDatabaseConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMKustoDataConnectionName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"cluster_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMKustoClusterName,
+			},
+
+			"cluster_resource_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"database_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMKustoDatabaseName,
+			},
+
+			"default_principal_modification_kind": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(kusto.DefaultPrincipalsModificationKindNone),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(kusto.DefaultPrincipalsModificationKindNone),
+					string(kusto.DefaultPrincipalsModificationKindReplace),
+					string(kusto.DefaultPrincipalsModificationKindUnion),
+				}, false),
+			},
+
+			"attached_database_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceArmKustoAttachedDatabaseConfigurationCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Kusto.AttachedDatabaseConfigurationsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Azure Kusto Attached Database Configuration creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	clusterName := d.Get("cluster_name").(string)
+
+	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, clusterName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Kusto Attached Database Configuration %q (Resource Group %q, Cluster %q): %s", name, resourceGroup, clusterName, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_kusto_attached_database_configuration", *existing.ID)
+		}
+	}
+
+	location := azure.NormalizeLocation(d.Get("location").(string))
+
+	configuration := kusto.AttachedDatabaseConfiguration{
+		Location: &location,
+		AttachedDatabaseConfigurationProperties: &kusto.AttachedDatabaseConfigurationProperties{
+			ClusterResourceID:                 utils.String(d.Get("cluster_resource_id").(string)),
+			DatabaseName:                      utils.String(d.Get("database_name").(string)),
+			DefaultPrincipalsModificationKind: kusto.DefaultPrincipalsModificationKind(d.Get("default_principal_modification_kind").(string)),
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, clusterName, name, configuration)
+	if err != nil {
+		return fmt.Errorf("Error creating or updating Kusto Attached Database Configuration %q (Resource Group %q, Cluster %q): %+v", name, resourceGroup, clusterName, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for completion of Kusto Attached Database Configuration %q (Resource Group %q, Cluster %q): %+v", name, resourceGroup, clusterName, err)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, clusterName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Kusto Attached Database Configuration %q (Resource Group %q, Cluster %q): %+v", name, resourceGroup, clusterName, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read ID for Kusto Attached Database Configuration %q (Resource Group %q, Cluster %q)", name, resourceGroup, clusterName)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmKustoAttachedDatabaseConfigurationRead(d, meta)
+}
+
+func resourceArmKustoAttachedDatabaseConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Kusto.AttachedDatabaseConfigurationsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.KustoAttachedDatabaseConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Cluster, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Kusto Attached Database Configuration %q (Resource Group %q, Cluster %q): %+v", id.Name, id.ResourceGroup, id.Cluster, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("cluster_name", id.Cluster)
+
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	if props := resp.AttachedDatabaseConfigurationProperties; props != nil {
+		d.Set("cluster_resource_id", props.ClusterResourceID)
+		d.Set("database_name", props.DatabaseName)
+		d.Set("default_principal_modification_kind", string(props.DefaultPrincipalsModificationKind))
+
+		attachedDatabaseNames := make([]interface{}, 0)
+		if props.AttachedDatabaseNames != nil {
+			for _, databaseName := range *props.AttachedDatabaseNames {
+				attachedDatabaseNames = append(attachedDatabaseNames, databaseName)
+			}
+		}
+		d.Set("attached_database_names", attachedDatabaseNames)
+	}
+
+	return nil
+}
+
+func resourceArmKustoAttachedDatabaseConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Kusto.AttachedDatabaseConfigurationsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.KustoAttachedDatabaseConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Cluster, id.Name)
+	if err != nil {
+		return fmt.Errorf("Error deleting Kusto Attached Database Configuration %q (Resource Group %q, Cluster %q): %+v", id.Name, id.ResourceGroup, id.Cluster, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of Kusto Attached Database Configuration %q (Resource Group %q, Cluster %q): %+v", id.Name, id.ResourceGroup, id.Cluster, err)
+	}
+
+	return nil
+}
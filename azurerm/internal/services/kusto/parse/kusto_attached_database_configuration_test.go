@@ -0,0 +1,63 @@
+package parse
+
+import (
+	"testing"
+)
+
+func TestKustoAttachedDatabaseConfigurationId(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Expected *KustoAttachedDatabaseConfigurationId
+	}{
+		{
+			Name:     "Empty",
+			Input:    "",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing Cluster",
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Kusto/attachedDatabaseConfigurations/config1",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing Attached Database Configuration",
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Kusto/Clusters/cluster1",
+			Expected: nil,
+		},
+		{
+			Name:  "Attached Database Configuration ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Kusto/Clusters/cluster1/attachedDatabaseConfigurations/config1",
+			Expected: &KustoAttachedDatabaseConfigurationId{
+				Name:          "config1",
+				Cluster:       "cluster1",
+				ResourceGroup: "group1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := KustoAttachedDatabaseConfigurationID(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+
+		if actual.Cluster != v.Expected.Cluster {
+			t.Fatalf("Expected %q but got %q for Cluster", v.Expected.Cluster, actual.Cluster)
+		}
+
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for Resource Group", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+	}
+}
@@ -179,6 +179,33 @@ func resourceArmMonitorActionGroup() *schema.Resource {
 							Type:     schema.TypeBool,
 							Optional: true,
 						},
+
+						"aad_auth": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"object_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.IsUUID,
+									},
+
+									"identifier_uri": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.IsURLWithScheme([]string{"http", "https"}),
+									},
+
+									"tenant_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.IsUUID,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -570,6 +597,21 @@ func expandMonitorActionGroupWebHookReceiver(v []interface{}) *[]insights.Webhoo
 			ServiceURI:           utils.String(val["service_uri"].(string)),
 			UseCommonAlertSchema: utils.Bool(val["use_common_alert_schema"].(bool)),
 		}
+
+		if aadAuthRaw := val["aad_auth"].([]interface{}); len(aadAuthRaw) > 0 && aadAuthRaw[0] != nil {
+			aadAuth := aadAuthRaw[0].(map[string]interface{})
+			receiver.UseAadAuth = utils.Bool(true)
+			receiver.ObjectID = utils.String(aadAuth["object_id"].(string))
+
+			if v, ok := aadAuth["identifier_uri"]; ok && v.(string) != "" {
+				receiver.IdentifierURI = utils.String(v.(string))
+			}
+
+			if v, ok := aadAuth["tenant_id"]; ok && v.(string) != "" {
+				receiver.TenantID = utils.String(v.(string))
+			}
+		}
+
 		receivers = append(receivers, receiver)
 	}
 	return &receivers
@@ -750,6 +792,7 @@ func flattenMonitorActionGroupWebHookReceiver(receivers *[]insights.WebhookRecei
 			if receiver.UseCommonAlertSchema != nil {
 				val["use_common_alert_schema"] = *receiver.UseCommonAlertSchema
 			}
+			val["aad_auth"] = flattenMonitorActionGroupWebHookReceiverAadAuth(receiver)
 
 			result = append(result, val)
 		}
@@ -757,6 +800,25 @@ func flattenMonitorActionGroupWebHookReceiver(receivers *[]insights.WebhookRecei
 	return result
 }
 
+func flattenMonitorActionGroupWebHookReceiverAadAuth(receiver insights.WebhookReceiver) []interface{} {
+	if receiver.UseAadAuth == nil || !*receiver.UseAadAuth {
+		return []interface{}{}
+	}
+
+	aadAuth := make(map[string]interface{})
+	if receiver.ObjectID != nil {
+		aadAuth["object_id"] = *receiver.ObjectID
+	}
+	if receiver.IdentifierURI != nil {
+		aadAuth["identifier_uri"] = *receiver.IdentifierURI
+	}
+	if receiver.TenantID != nil {
+		aadAuth["tenant_id"] = *receiver.TenantID
+	}
+
+	return []interface{}{aadAuth}
+}
+
 func flattenMonitorActionGroupAutomationRunbookReceiver(receivers *[]insights.AutomationRunbookReceiver) []interface{} {
 	result := make([]interface{}, 0)
 	if receivers != nil {
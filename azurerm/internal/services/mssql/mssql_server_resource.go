@@ -97,6 +97,12 @@ func resourceArmMsSqlServer() *schema.Resource {
 							Computed:     true,
 							ValidateFunc: validation.IsUUID,
 						},
+
+						"azuread_authentication_only": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 					},
 				},
 			},
@@ -152,6 +158,16 @@ func resourceArmMsSqlServer() *schema.Resource {
 
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			if aadOnly, ok := diff.GetOk("azuread_administrator.0.azuread_authentication_only"); ok && aadOnly.(bool) {
+				if diff.HasChange("administrator_login_password") {
+					return fmt.Errorf("`administrator_login_password` cannot be changed when `azuread_administrator.0.azuread_authentication_only` is `true` - Azure AD-only authentication disables the SQL login for this server")
+				}
+			}
+
+			return nil
+		},
 	}
 }
 
@@ -230,6 +246,21 @@ func resourceArmMsSqlServerCreateUpdate(d *schema.ResourceData, meta interface{}
 	d.SetId(*resp.ID)
 
 	if d.HasChange("azuread_administrator") {
+		// Azure AD-only authentication must be disabled before the AAD admin backing it can be deleted/replaced
+		oldAdminRaw, _ := d.GetChange("azuread_administrator")
+		if oldAdmin := oldAdminRaw.([]interface{}); len(oldAdmin) > 0 && oldAdmin[0] != nil {
+			if oldAdmin[0].(map[string]interface{})["azuread_authentication_only"].(bool) {
+				aadOnlyDisableFuture, err := adminClient.DisableAzureADOnlyAuthentication(ctx, resGroup, name)
+				if err != nil {
+					return fmt.Errorf("disabling Azure AD-only authentication for SQL Server %q (Resource Group %q): %+v", name, resGroup, err)
+				}
+
+				if err = aadOnlyDisableFuture.WaitForCompletionRef(ctx, adminClient.Client); err != nil {
+					return fmt.Errorf("waiting for Azure AD-only authentication to be disabled for SQL Server %q (Resource Group %q): %+v", name, resGroup, err)
+				}
+			}
+		}
+
 		adminDelFuture, err := adminClient.Delete(ctx, resGroup, name)
 		if err != nil {
 			return fmt.Errorf("deleting SQL Server %q AAD admin (Resource Group %q): %+v", name, resGroup, err)
@@ -410,9 +441,10 @@ func expandAzureRmMsSqlServerAdministrator(input []interface{}) *sql.ServerAzure
 
 	adminParams := sql.ServerAzureADAdministrator{
 		AdministratorProperties: &sql.AdministratorProperties{
-			AdministratorType: utils.String("ActiveDirectory"),
-			Login:             utils.String(admin["login_username"].(string)),
-			Sid:               &sid,
+			AdministratorType:         utils.String("ActiveDirectory"),
+			Login:                     utils.String(admin["login_username"].(string)),
+			Sid:                       &sid,
+			AzureADOnlyAuthentication: utils.Bool(admin["azuread_authentication_only"].(bool)),
 		},
 	}
 
@@ -438,11 +470,17 @@ func flatternAzureRmMsSqlServerAdministrator(admin sql.ServerAzureADAdministrato
 		tid = admin.TenantID.String()
 	}
 
+	aadOnly := false
+	if admin.AzureADOnlyAuthentication != nil {
+		aadOnly = *admin.AzureADOnlyAuthentication
+	}
+
 	return []interface{}{
 		map[string]interface{}{
-			"login_username": login,
-			"object_id":      sid,
-			"tenant_id":      tid,
+			"login_username":              login,
+			"object_id":                   sid,
+			"tenant_id":                   tid,
+			"azuread_authentication_only": aadOnly,
 		},
 	}
 }
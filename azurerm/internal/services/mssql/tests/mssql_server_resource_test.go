@@ -3,6 +3,7 @@ package tests
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -188,6 +189,64 @@ func TestAccAzureRMMsSqlServer_azureadAdmin(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMMsSqlServer_azureadAuthenticationOnly(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_server", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMMsSqlServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlServer_azureadAdmin(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlServerExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "azuread_administrator.0.azuread_authentication_only", "false"),
+				),
+			},
+			data.ImportStep("administrator_login_password"),
+			{
+				Config: testAccAzureRMMsSqlServer_azureadAuthenticationOnly(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlServerExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "azuread_administrator.0.azuread_authentication_only", "true"),
+				),
+			},
+			data.ImportStep("administrator_login_password"),
+			{
+				Config: testAccAzureRMMsSqlServer_azureadAdmin(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlServerExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "azuread_administrator.0.azuread_authentication_only", "false"),
+				),
+			},
+			data.ImportStep("administrator_login_password"),
+		},
+	})
+}
+
+func TestAccAzureRMMsSqlServer_azureadAuthenticationOnlyBlocksPasswordChange(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_server", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMMsSqlServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlServer_azureadAuthenticationOnly(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlServerExists(data.ResourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMMsSqlServer_azureadAuthenticationOnlyPasswordChange(data),
+				ExpectError: regexp.MustCompile("`administrator_login_password` cannot be changed when `azuread_administrator.0.azuread_authentication_only` is `true`"),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMMsSqlServer_blobAuditingPolicies_withFirewall(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_mssql_server", "test")
 
@@ -513,6 +572,70 @@ resource "azurerm_mssql_server" "test" {
 `, data.RandomInteger, data.Locations.Primary, os.Getenv("ARM_CLIENT_ID"))
 }
 
+func testAccAzureRMMsSqlServer_azureadAuthenticationOnly(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-mssql-%[1]d"
+  location = "%[2]s"
+}
+
+data "azuread_service_principal" "test" {
+  application_id = "%[3]s"
+}
+
+resource "azurerm_mssql_server" "test" {
+  name                         = "acctestsqlserver%[1]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  version                      = "12.0"
+  administrator_login          = "missadministrator"
+  administrator_login_password = "thisIsKat11"
+
+  azuread_administrator {
+    login_username              = "AzureAD Admin"
+    object_id                   = data.azuread_service_principal.test.id
+    azuread_authentication_only = true
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, os.Getenv("ARM_CLIENT_ID"))
+}
+
+func testAccAzureRMMsSqlServer_azureadAuthenticationOnlyPasswordChange(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-mssql-%[1]d"
+  location = "%[2]s"
+}
+
+data "azuread_service_principal" "test" {
+  application_id = "%[3]s"
+}
+
+resource "azurerm_mssql_server" "test" {
+  name                         = "acctestsqlserver%[1]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  version                      = "12.0"
+  administrator_login          = "missadministrator"
+  administrator_login_password = "thisIsKatDifferent11"
+
+  azuread_administrator {
+    login_username              = "AzureAD Admin"
+    object_id                   = data.azuread_service_principal.test.id
+    azuread_authentication_only = true
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, os.Getenv("ARM_CLIENT_ID"))
+}
+
 func testAccAzureRMMsSqlServer_blobAuditingPolicies_withFirewall(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
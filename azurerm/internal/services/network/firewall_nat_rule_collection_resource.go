@@ -84,7 +84,11 @@ func resourceArmFirewallNatRuleCollection() *schema.Resource {
 						},
 						"translated_address": {
 							Type:     schema.TypeString,
-							Required: true,
+							Optional: true,
+						},
+						"translated_fqdn": {
+							Type:     schema.TypeString,
+							Optional: true,
 						},
 						"translated_port": {
 							Type:     schema.TypeString,
@@ -156,7 +160,10 @@ func resourceArmFirewallNatRuleCollectionCreateUpdate(d *schema.ResourceData, me
 	}
 
 	ruleCollections := *props.NatRuleCollections
-	natRules := expandArmFirewallNatRules(d.Get("rule").(*schema.Set))
+	natRules, err := expandArmFirewallNatRules(d.Get("rule").(*schema.Set))
+	if err != nil {
+		return err
+	}
 	priority := d.Get("priority").(int)
 	newRuleCollection := network.AzureFirewallNatRuleCollection{
 		Name: utils.String(name),
@@ -370,7 +377,7 @@ func resourceArmFirewallNatRuleCollectionDelete(d *schema.ResourceData, meta int
 	return nil
 }
 
-func expandArmFirewallNatRules(input *schema.Set) []network.AzureFirewallNatRule {
+func expandArmFirewallNatRules(input *schema.Set) ([]network.AzureFirewallNatRule, error) {
 	nwRules := input.List()
 	rules := make([]network.AzureFirewallNatRule, 0)
 
@@ -396,17 +403,30 @@ func expandArmFirewallNatRules(input *schema.Set) []network.AzureFirewallNatRule
 		}
 
 		translatedAddress := rule["translated_address"].(string)
+		translatedFqdn := rule["translated_fqdn"].(string)
 		translatedPort := rule["translated_port"].(string)
 
+		if translatedAddress == "" && translatedFqdn == "" {
+			return nil, fmt.Errorf("one of `translated_address` or `translated_fqdn` must be specified for NAT Rule %q", name)
+		}
+		if translatedAddress != "" && translatedFqdn != "" {
+			return nil, fmt.Errorf("only one of `translated_address` or `translated_fqdn` can be specified for NAT Rule %q", name)
+		}
+
 		ruleToAdd := network.AzureFirewallNatRule{
 			Name:                 utils.String(name),
 			Description:          utils.String(description),
 			SourceAddresses:      &sourceAddresses,
 			DestinationAddresses: &destinationAddresses,
 			DestinationPorts:     &destinationPorts,
-			TranslatedAddress:    &translatedAddress,
 			TranslatedPort:       &translatedPort,
 		}
+		if translatedAddress != "" {
+			ruleToAdd.TranslatedAddress = &translatedAddress
+		}
+		if translatedFqdn != "" {
+			ruleToAdd.TranslatedFqdn = &translatedFqdn
+		}
 
 		nrProtocols := make([]network.AzureFirewallNetworkRuleProtocol, 0)
 		protocols := rule["protocols"].(*schema.Set)
@@ -418,7 +438,7 @@ func expandArmFirewallNatRules(input *schema.Set) []network.AzureFirewallNatRule
 		rules = append(rules, ruleToAdd)
 	}
 
-	return rules
+	return rules, nil
 }
 
 func flattenFirewallNatRuleCollectionRules(rules *[]network.AzureFirewallNatRule) []map[string]interface{} {
@@ -438,6 +458,9 @@ func flattenFirewallNatRuleCollectionRules(rules *[]network.AzureFirewallNatRule
 		if rule.TranslatedAddress != nil {
 			output["translated_address"] = *rule.TranslatedAddress
 		}
+		if rule.TranslatedFqdn != nil {
+			output["translated_fqdn"] = *rule.TranslatedFqdn
+		}
 		if rule.TranslatedPort != nil {
 			output["translated_port"] = *rule.TranslatedPort
 		}
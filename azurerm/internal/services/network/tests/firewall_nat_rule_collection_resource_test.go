@@ -2,6 +2,7 @@ package tests
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-03-01/network"
@@ -30,6 +31,42 @@ func TestAccAzureRMFirewallNatRuleCollection_basic(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMFirewallNatRuleCollection_translatedFqdn(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_nat_rule_collection", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMFirewallDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFirewallNatRuleCollection_translatedFqdn(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFirewallNatRuleCollectionExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "rule.0.translated_fqdn", "www.contoso.com"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func TestAccAzureRMFirewallNatRuleCollection_translatedAddressAndFqdnConflict(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_nat_rule_collection", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMFirewallDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAzureRMFirewallNatRuleCollection_translatedAddressAndFqdnConflict(data),
+				ExpectError: regexp.MustCompile("only one of `translated_address` or `translated_fqdn` can be specified"),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMFirewallNatRuleCollection_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_firewall_nat_rule_collection", "test")
 
@@ -368,6 +405,83 @@ resource "azurerm_firewall_nat_rule_collection" "test" {
 `, template, data.RandomInteger)
 }
 
+func testAccAzureRMFirewallNatRuleCollection_translatedFqdn(data acceptance.TestData) string {
+	template := testAccAzureRMFirewall_basic(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_nat_rule_collection" "test" {
+  name                = "acctestnrc-%d"
+  azure_firewall_name = azurerm_firewall.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  priority            = 100
+  action              = "Dnat"
+
+  rule {
+    name = "rule1"
+
+    source_addresses = [
+      "10.0.0.0/16",
+    ]
+
+    destination_ports = [
+      "80",
+    ]
+
+    destination_addresses = [
+      azurerm_public_ip.test.ip_address,
+    ]
+
+    protocols = [
+      "TCP",
+    ]
+
+    translated_port = 80
+    translated_fqdn  = "www.contoso.com"
+  }
+}
+`, template, data.RandomInteger)
+}
+
+func testAccAzureRMFirewallNatRuleCollection_translatedAddressAndFqdnConflict(data acceptance.TestData) string {
+	template := testAccAzureRMFirewall_basic(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_nat_rule_collection" "test" {
+  name                = "acctestnrc-%d"
+  azure_firewall_name = azurerm_firewall.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  priority            = 100
+  action              = "Dnat"
+
+  rule {
+    name = "rule1"
+
+    source_addresses = [
+      "10.0.0.0/16",
+    ]
+
+    destination_ports = [
+      "80",
+    ]
+
+    destination_addresses = [
+      azurerm_public_ip.test.ip_address,
+    ]
+
+    protocols = [
+      "TCP",
+    ]
+
+    translated_port     = 80
+    translated_address  = "8.8.8.8"
+    translated_fqdn     = "www.contoso.com"
+  }
+}
+`, template, data.RandomInteger)
+}
+
 func testAccAzureRMFirewallNatRuleCollection_requiresImport(data acceptance.TestData) string {
 	template := testAccAzureRMFirewallNatRuleCollection_basic(data)
 	return fmt.Sprintf(`
@@ -20,6 +20,7 @@ type Client struct {
 	ContainerMappingClient           func(resourceGroupName string, vaultName string) siterecovery.ReplicationProtectionContainerMappingsClient
 	NetworkMappingClient             func(resourceGroupName string, vaultName string) siterecovery.ReplicationNetworkMappingsClient
 	ReplicationMigrationItemsClient  func(resourceGroupName string, vaultName string) siterecovery.ReplicationProtectedItemsClient
+	ReplicationRecoveryPlansClient   func(resourceGroupName string, vaultName string) siterecovery.ReplicationRecoveryPlansClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -77,6 +78,12 @@ func NewClient(o *common.ClientOptions) *Client {
 		return client
 	}
 
+	replicationRecoveryPlansClient := func(resourceGroupName string, vaultName string) siterecovery.ReplicationRecoveryPlansClient {
+		client := siterecovery.NewReplicationRecoveryPlansClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId, resourceGroupName, vaultName)
+		o.ConfigureClient(&client.Client, o.ResourceManagerAuthorizer)
+		return client
+	}
+
 	return &Client{
 		ProtectedItemsClient:             &protectedItemsClient,
 		ProtectionPoliciesClient:         &protectionPoliciesClient,
@@ -90,5 +97,6 @@ func NewClient(o *common.ClientOptions) *Client {
 		ContainerMappingClient:           containerMappingClient,
 		NetworkMappingClient:             networkMappingClient,
 		ReplicationMigrationItemsClient:  replicationMigrationItemsClient,
+		ReplicationRecoveryPlansClient:   replicationRecoveryPlansClient,
 	}
 }
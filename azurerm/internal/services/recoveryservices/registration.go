@@ -41,5 +41,6 @@ func (r Registration) SupportedResources() map[string]*schema.Resource {
 		"azurerm_site_recovery_protection_container_mapping": resourceArmSiteRecoveryProtectionContainerMapping(),
 		"azurerm_site_recovery_replicated_vm":                resourceArmSiteRecoveryReplicatedVM(),
 		"azurerm_site_recovery_replication_policy":           resourceArmSiteRecoveryReplicationPolicy(),
+		"azurerm_site_recovery_replication_recovery_plan":    resourceArmSiteRecoveryReplicationRecoveryPlan(),
 	}
 }
@@ -69,6 +69,13 @@ func resourceArmBackupProtectionPolicyVM() *schema.Resource {
 				Default:  "UTC",
 			},
 
+			"instant_restore_retention_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntBetween(1, 5),
+			},
+
 			"backup": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -317,9 +324,10 @@ func resourceArmBackupProtectionPolicyVMCreateUpdate(d *schema.ResourceData, met
 	policy := backup.ProtectionPolicyResource{
 		Tags: tags.Expand(t),
 		Properties: &backup.AzureIaaSVMProtectionPolicy{
-			TimeZone:             utils.String(d.Get("timezone").(string)),
-			BackupManagementType: backup.BackupManagementTypeAzureIaasVM,
-			SchedulePolicy:       expandArmBackupProtectionPolicyVMSchedule(d, times),
+			TimeZone:                      utils.String(d.Get("timezone").(string)),
+			BackupManagementType:          backup.BackupManagementTypeAzureIaasVM,
+			InstantRpRetentionRangeInDays: utils.Int32(int32(d.Get("instant_restore_retention_days").(int))),
+			SchedulePolicy:                expandArmBackupProtectionPolicyVMSchedule(d, times),
 			RetentionPolicy: &backup.LongTermRetentionPolicy{ // SimpleRetentionPolicy only has duration property ¯\_(ツ)_/¯
 				RetentionPolicyType: backup.RetentionPolicyTypeLongTermRetentionPolicy,
 				DailySchedule:       expandArmBackupProtectionPolicyVMRetentionDaily(d, times),
@@ -377,6 +385,10 @@ func resourceArmBackupProtectionPolicyVMRead(d *schema.ResourceData, meta interf
 	if properties, ok := resp.Properties.AsAzureIaaSVMProtectionPolicy(); ok && properties != nil {
 		d.Set("timezone", properties.TimeZone)
 
+		if v := properties.InstantRpRetentionRangeInDays; v != nil {
+			d.Set("instant_restore_retention_days", int(*v))
+		}
+
 		if schedule, ok := properties.SchedulePolicy.AsSimpleSchedulePolicy(); ok && schedule != nil {
 			if err := d.Set("backup", flattenArmBackupProtectionPolicyVMSchedule(schedule)); err != nil {
 				return fmt.Errorf("Error setting `backup`: %+v", err)
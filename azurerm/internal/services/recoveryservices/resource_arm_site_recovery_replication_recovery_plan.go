@@ -0,0 +1,477 @@
+package recoveryservices
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2018-01-10/siterecovery"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmSiteRecoveryReplicationRecoveryPlan() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSiteRecoveryReplicationRecoveryPlanCreate,
+		Read:   resourceArmSiteRecoveryReplicationRecoveryPlanRead,
+		Update: resourceArmSiteRecoveryReplicationRecoveryPlanUpdate,
+		Delete: resourceArmSiteRecoveryReplicationRecoveryPlanDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"recovery_vault_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateRecoveryServicesVaultName,
+			},
+			"source_recovery_fabric_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"target_recovery_fabric_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"recovery_group": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(siterecovery.Boot),
+								string(siterecovery.Failover),
+								string(siterecovery.Shutdown),
+							}, false),
+						},
+						"replicated_protected_items": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+						"pre_action":  siteRecoveryReplicationRecoveryPlanActionSchema(),
+						"post_action": siteRecoveryReplicationRecoveryPlanActionSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func siteRecoveryReplicationRecoveryPlanActionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				"type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(siterecovery.InstanceTypeManualActionDetails),
+						string(siterecovery.InstanceTypeScriptActionDetails),
+						string(siterecovery.InstanceTypeAutomationRunbookActionDetails),
+					}, false),
+				},
+				"fail_over_directions": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(siterecovery.PrimaryToRecovery),
+							string(siterecovery.RecoveryToPrimary),
+						}, false),
+					},
+				},
+				"fail_over_types": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(siterecovery.ReplicationProtectedItemOperationTestFailover),
+							string(siterecovery.ReplicationProtectedItemOperationPlannedFailover),
+							string(siterecovery.ReplicationProtectedItemOperationUnplannedFailover),
+						}, false),
+					},
+				},
+				"manual_action_instruction": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"script_path": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"runbook_id": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"fabric_location": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(siterecovery.Primary),
+						string(siterecovery.Recovery),
+					}, false),
+				},
+			},
+		},
+	}
+}
+
+func resourceArmSiteRecoveryReplicationRecoveryPlanCreate(d *schema.ResourceData, meta interface{}) error {
+	resGroup := d.Get("resource_group_name").(string)
+	vaultName := d.Get("recovery_vault_name").(string)
+	name := d.Get("name").(string)
+
+	client := meta.(*clients.Client).RecoveryServices.ReplicationRecoveryPlansClient(resGroup, vaultName)
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+		existing, err := client.Get(ctx, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing site recovery replication recovery plan %s: %+v", name, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_site_recovery_replication_recovery_plan", azure.HandleAzureSdkForGoBug2824(*existing.ID))
+		}
+	}
+
+	groups, err := expandArmSiteRecoveryReplicationRecoveryPlanGroups(d)
+	if err != nil {
+		return err
+	}
+
+	parameters := siterecovery.CreateRecoveryPlanInput{
+		Properties: &siterecovery.CreateRecoveryPlanInputProperties{
+			PrimaryFabricID:         utils.String(d.Get("source_recovery_fabric_id").(string)),
+			RecoveryFabricID:        utils.String(d.Get("target_recovery_fabric_id").(string)),
+			FailoverDeploymentModel: siterecovery.ResourceManager,
+			Groups:                  &groups,
+		},
+	}
+	future, err := client.Create(ctx, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating site recovery replication recovery plan %s (vault %s): %+v", name, vaultName, err)
+	}
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error creating site recovery replication recovery plan %s (vault %s): %+v", name, vaultName, err)
+	}
+
+	resp, err := client.Get(ctx, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving site recovery replication recovery plan %s (vault %s): %+v", name, vaultName, err)
+	}
+
+	d.SetId(azure.HandleAzureSdkForGoBug2824(*resp.ID))
+
+	return resourceArmSiteRecoveryReplicationRecoveryPlanRead(d, meta)
+}
+
+func resourceArmSiteRecoveryReplicationRecoveryPlanUpdate(d *schema.ResourceData, meta interface{}) error {
+	resGroup := d.Get("resource_group_name").(string)
+	vaultName := d.Get("recovery_vault_name").(string)
+	name := d.Get("name").(string)
+
+	client := meta.(*clients.Client).RecoveryServices.ReplicationRecoveryPlansClient(resGroup, vaultName)
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	groups, err := expandArmSiteRecoveryReplicationRecoveryPlanGroups(d)
+	if err != nil {
+		return err
+	}
+
+	parameters := siterecovery.UpdateRecoveryPlanInput{
+		Properties: &siterecovery.UpdateRecoveryPlanInputProperties{
+			Groups: &groups,
+		},
+	}
+	future, err := client.Update(ctx, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error updating site recovery replication recovery plan %s (vault %s): %+v", name, vaultName, err)
+	}
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error updating site recovery replication recovery plan %s (vault %s): %+v", name, vaultName, err)
+	}
+
+	resp, err := client.Get(ctx, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving site recovery replication recovery plan %s (vault %s): %+v", name, vaultName, err)
+	}
+
+	d.SetId(azure.HandleAzureSdkForGoBug2824(*resp.ID))
+
+	return resourceArmSiteRecoveryReplicationRecoveryPlanRead(d, meta)
+}
+
+func resourceArmSiteRecoveryReplicationRecoveryPlanRead(d *schema.ResourceData, meta interface{}) error {
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	vaultName := id.Path["vaults"]
+	name := id.Path["replicationRecoveryPlans"]
+
+	client := meta.(*clients.Client).RecoveryServices.ReplicationRecoveryPlansClient(resGroup, vaultName)
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	resp, err := client.Get(ctx, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on site recovery replication recovery plan %s (vault %s): %+v", name, vaultName, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("recovery_vault_name", vaultName)
+	if props := resp.Properties; props != nil {
+		d.Set("source_recovery_fabric_id", props.PrimaryFabricID)
+		d.Set("target_recovery_fabric_id", props.RecoveryFabricID)
+		if err := d.Set("recovery_group", flattenArmSiteRecoveryReplicationRecoveryPlanGroups(props.Groups)); err != nil {
+			return fmt.Errorf("Error setting `recovery_group`: %+v", err)
+		}
+	}
+	return nil
+}
+
+func resourceArmSiteRecoveryReplicationRecoveryPlanDelete(d *schema.ResourceData, meta interface{}) error {
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	vaultName := id.Path["vaults"]
+	name := id.Path["replicationRecoveryPlans"]
+
+	client := meta.(*clients.Client).RecoveryServices.ReplicationRecoveryPlansClient(resGroup, vaultName)
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	future, err := client.Delete(ctx, name)
+	if err != nil {
+		return fmt.Errorf("Error deleting site recovery replication recovery plan %s (vault %s): %+v", name, vaultName, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of site recovery replication recovery plan %s (vault %s): %+v", name, vaultName, err)
+	}
+
+	return nil
+}
+
+func expandArmSiteRecoveryReplicationRecoveryPlanGroups(d *schema.ResourceData) ([]siterecovery.RecoveryPlanGroup, error) {
+	groupsRaw := d.Get("recovery_group").([]interface{})
+	groups := make([]siterecovery.RecoveryPlanGroup, 0)
+
+	for _, groupRaw := range groupsRaw {
+		group := groupRaw.(map[string]interface{})
+
+		protectedItems := make([]siterecovery.RecoveryPlanProtectedItem, 0)
+		for _, itemRaw := range group["replicated_protected_items"].([]interface{}) {
+			protectedItems = append(protectedItems, siterecovery.RecoveryPlanProtectedItem{
+				ID: utils.String(itemRaw.(string)),
+			})
+		}
+
+		preActions, err := expandArmSiteRecoveryReplicationRecoveryPlanActions(group["pre_action"].([]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		postActions, err := expandArmSiteRecoveryReplicationRecoveryPlanActions(group["post_action"].([]interface{}))
+		if err != nil {
+			return nil, err
+		}
+
+		groups = append(groups, siterecovery.RecoveryPlanGroup{
+			GroupType:                 siterecovery.RecoveryPlanGroupType(group["type"].(string)),
+			ReplicationProtectedItems: &protectedItems,
+			StartGroupActions:         &preActions,
+			EndGroupActions:           &postActions,
+		})
+	}
+
+	return groups, nil
+}
+
+func expandArmSiteRecoveryReplicationRecoveryPlanActions(actionsRaw []interface{}) ([]siterecovery.RecoveryPlanAction, error) {
+	actions := make([]siterecovery.RecoveryPlanAction, 0)
+
+	for _, actionRaw := range actionsRaw {
+		action := actionRaw.(map[string]interface{})
+
+		failoverDirections := make([]siterecovery.PossibleOperationsDirections, 0)
+		for _, v := range action["fail_over_directions"].([]interface{}) {
+			failoverDirections = append(failoverDirections, siterecovery.PossibleOperationsDirections(v.(string)))
+		}
+
+		failoverTypes := make([]siterecovery.ReplicationProtectedItemOperation, 0)
+		for _, v := range action["fail_over_types"].([]interface{}) {
+			failoverTypes = append(failoverTypes, siterecovery.ReplicationProtectedItemOperation(v.(string)))
+		}
+
+		var customDetails siterecovery.BasicRecoveryPlanActionDetails
+		switch action["type"].(string) {
+		case string(siterecovery.InstanceTypeManualActionDetails):
+			customDetails = siterecovery.RecoveryPlanManualActionDetails{
+				Description:  utils.String(action["manual_action_instruction"].(string)),
+				InstanceType: siterecovery.InstanceTypeManualActionDetails,
+			}
+		case string(siterecovery.InstanceTypeScriptActionDetails):
+			customDetails = siterecovery.RecoveryPlanScriptActionDetails{
+				Path:           utils.String(action["script_path"].(string)),
+				FabricLocation: siterecovery.RecoveryPlanActionLocation(action["fabric_location"].(string)),
+				InstanceType:   siterecovery.InstanceTypeScriptActionDetails,
+			}
+		case string(siterecovery.InstanceTypeAutomationRunbookActionDetails):
+			customDetails = siterecovery.RecoveryPlanAutomationRunbookActionDetails{
+				RunbookID:      utils.String(action["runbook_id"].(string)),
+				FabricLocation: siterecovery.RecoveryPlanActionLocation(action["fabric_location"].(string)),
+				InstanceType:   siterecovery.InstanceTypeAutomationRunbookActionDetails,
+			}
+		default:
+			return nil, fmt.Errorf("Unknown recovery plan action type %q", action["type"].(string))
+		}
+
+		actions = append(actions, siterecovery.RecoveryPlanAction{
+			ActionName:         utils.String(action["name"].(string)),
+			FailoverDirections: &failoverDirections,
+			FailoverTypes:      &failoverTypes,
+			CustomDetails:      customDetails,
+		})
+	}
+
+	return actions, nil
+}
+
+func flattenArmSiteRecoveryReplicationRecoveryPlanGroups(groups *[]siterecovery.RecoveryPlanGroup) []interface{} {
+	result := make([]interface{}, 0)
+	if groups == nil {
+		return result
+	}
+
+	for _, group := range *groups {
+		protectedItems := make([]interface{}, 0)
+		if group.ReplicationProtectedItems != nil {
+			for _, item := range *group.ReplicationProtectedItems {
+				if item.ID != nil {
+					protectedItems = append(protectedItems, *item.ID)
+				}
+			}
+		}
+
+		result = append(result, map[string]interface{}{
+			"type":                       string(group.GroupType),
+			"replicated_protected_items": protectedItems,
+			"pre_action":                 flattenArmSiteRecoveryReplicationRecoveryPlanActions(group.StartGroupActions),
+			"post_action":                flattenArmSiteRecoveryReplicationRecoveryPlanActions(group.EndGroupActions),
+		})
+	}
+
+	return result
+}
+
+func flattenArmSiteRecoveryReplicationRecoveryPlanActions(actions *[]siterecovery.RecoveryPlanAction) []interface{} {
+	result := make([]interface{}, 0)
+	if actions == nil {
+		return result
+	}
+
+	for _, action := range *actions {
+		item := map[string]interface{}{
+			"fail_over_directions": []interface{}{},
+			"fail_over_types":      []interface{}{},
+		}
+		if action.ActionName != nil {
+			item["name"] = *action.ActionName
+		}
+		if action.FailoverDirections != nil {
+			directions := make([]interface{}, 0)
+			for _, d := range *action.FailoverDirections {
+				directions = append(directions, string(d))
+			}
+			item["fail_over_directions"] = directions
+		}
+		if action.FailoverTypes != nil {
+			types := make([]interface{}, 0)
+			for _, t := range *action.FailoverTypes {
+				types = append(types, string(t))
+			}
+			item["fail_over_types"] = types
+		}
+
+		if manual, ok := action.CustomDetails.AsRecoveryPlanManualActionDetails(); ok {
+			item["type"] = string(siterecovery.InstanceTypeManualActionDetails)
+			if manual.Description != nil {
+				item["manual_action_instruction"] = *manual.Description
+			}
+		} else if script, ok := action.CustomDetails.AsRecoveryPlanScriptActionDetails(); ok {
+			item["type"] = string(siterecovery.InstanceTypeScriptActionDetails)
+			if script.Path != nil {
+				item["script_path"] = *script.Path
+			}
+			item["fabric_location"] = string(script.FabricLocation)
+		} else if runbook, ok := action.CustomDetails.AsRecoveryPlanAutomationRunbookActionDetails(); ok {
+			item["type"] = string(siterecovery.InstanceTypeAutomationRunbookActionDetails)
+			if runbook.RunbookID != nil {
+				item["runbook_id"] = *runbook.RunbookID
+			}
+			item["fabric_location"] = string(runbook.FabricLocation)
+		}
+
+		result = append(result, item)
+	}
+
+	return result
+}
@@ -83,6 +83,7 @@ func TestAccAzureRMBackupProtectionPolicyVM_completeDaily(t *testing.T) {
 				Config: testAccAzureRMBackupProtectionPolicyVM_completeDaily(data),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testCheckAzureRMBackupProtectionPolicyVmExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "instant_restore_retention_days", "5"),
 				),
 			},
 			data.ImportStep(),
@@ -444,6 +445,8 @@ resource "azurerm_backup_policy_vm" "test" {
   resource_group_name = azurerm_resource_group.test.name
   recovery_vault_name = azurerm_recovery_services_vault.test.name
 
+  instant_restore_retention_days = 5
+
   backup {
     frequency = "Daily"
     time      = "23:00"
@@ -0,0 +1,142 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+)
+
+func TestAccAzureRMSiteRecoveryReplicationRecoveryPlan_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_site_recovery_replication_recovery_plan", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMSiteRecoveryReplicationRecoveryPlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSiteRecoveryReplicationRecoveryPlan_basic(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSiteRecoveryReplicationRecoveryPlanExists(data.ResourceName),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testAccAzureRMSiteRecoveryReplicationRecoveryPlan_basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-recovery-%d"
+  location = "%s"
+}
+
+resource "azurerm_recovery_services_vault" "test" {
+  name                = "acctest-vault-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+
+  soft_delete_enabled = false
+}
+
+resource "azurerm_site_recovery_fabric" "primary" {
+  resource_group_name = azurerm_resource_group.test.name
+  recovery_vault_name = azurerm_recovery_services_vault.test.name
+  name                 = "acctest-primary-fabric-%d"
+  location             = azurerm_resource_group.test.location
+}
+
+resource "azurerm_site_recovery_fabric" "recovery" {
+  resource_group_name = azurerm_resource_group.test.name
+  recovery_vault_name = azurerm_recovery_services_vault.test.name
+  name                 = "acctest-recovery-fabric-%d"
+  location             = "%s"
+}
+
+resource "azurerm_site_recovery_replication_recovery_plan" "test" {
+  resource_group_name       = azurerm_resource_group.test.name
+  recovery_vault_name       = azurerm_recovery_services_vault.test.name
+  name                      = "acctest-plan-%d"
+  source_recovery_fabric_id = azurerm_site_recovery_fabric.primary.id
+  target_recovery_fabric_id = azurerm_site_recovery_fabric.recovery.id
+
+  recovery_group {
+    type = "Boot"
+
+    pre_action {
+      name                      = "pre-action"
+      type                      = "ManualActionDetails"
+      fail_over_directions      = ["PrimaryToRecovery"]
+      fail_over_types           = ["PlannedFailover"]
+      manual_action_instruction = "do something"
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.Locations.Secondary, data.RandomInteger)
+}
+
+func testCheckAzureRMSiteRecoveryReplicationRecoveryPlanExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+		// Ensure we have enough information in state to look up in API
+		state, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		resourceGroupName := state.Primary.Attributes["resource_group_name"]
+		vaultName := state.Primary.Attributes["recovery_vault_name"]
+		planName := state.Primary.Attributes["name"]
+
+		client := acceptance.AzureProvider.Meta().(*clients.Client).RecoveryServices.ReplicationRecoveryPlansClient(resourceGroupName, vaultName)
+
+		resp, err := client.Get(ctx, planName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on RecoveryServices.ReplicationRecoveryPlansClient: %+v", err)
+		}
+
+		if resp.Response.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: replication recovery plan: %q does not exist", planName)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMSiteRecoveryReplicationRecoveryPlanDestroy(s *terraform.State) error {
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_site_recovery_replication_recovery_plan" {
+			continue
+		}
+
+		resourceGroupName := rs.Primary.Attributes["resource_group_name"]
+		vaultName := rs.Primary.Attributes["recovery_vault_name"]
+		planName := rs.Primary.Attributes["name"]
+
+		client := acceptance.AzureProvider.Meta().(*clients.Client).RecoveryServices.ReplicationRecoveryPlansClient(resourceGroupName, vaultName)
+		resp, err := client.Get(ctx, planName)
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Replication Recovery Plan still exists:\n%#v", resp.Properties)
+		}
+	}
+
+	return nil
+}
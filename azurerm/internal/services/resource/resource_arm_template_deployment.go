@@ -29,6 +29,8 @@ func resourceArmTemplateDeployment() *schema.Resource {
 		Update: resourceArmTemplateDeploymentCreateUpdate,
 		Delete: resourceArmTemplateDeploymentDelete,
 
+		CustomizeDiff: resourceArmTemplateDeploymentWhatIfCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(180 * time.Minute),
 			Read:   schema.DefaultTimeout(5 * time.Minute),
@@ -85,10 +87,94 @@ func resourceArmTemplateDeployment() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+
+			"what_if": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Should the template be validated against Azure during `terraform plan`, surfacing any template or parameter errors as a plan-time diagnostic rather than waiting until `terraform apply`?",
+			},
 		},
 	}
 }
 
+// resourceArmTemplateDeploymentWhatIfCustomizeDiff runs the Deployments Validate API during
+// `terraform plan` when `what_if` is enabled, so that ARM template/parameter errors are
+// surfaced as a plan-time diagnostic instead of only being caught on `terraform apply`.
+//
+// NOTE: the version of the Resources API vendored by this provider predates the dedicated
+// What-If API (which returns a full predicted resource-change diff) - so this uses the
+// Validate API as the closest available approximation, which catches template and parameter
+// errors but does not return a change-by-change diff of the underlying resources.
+func resourceArmTemplateDeploymentWhatIfCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	// `schema.ResourceDiff` has no `Timeout` method to derive a context from (unlike
+	// `schema.ResourceData`), so bound this call directly off `StopContext` instead - this still
+	// ensures a Ctrl-C during `terraform plan` cancels the request rather than leaking it.
+	ctx, cancel := context.WithTimeout(meta.(*clients.Client).StopContext, 5*time.Minute)
+	defer cancel()
+
+	if !d.Get("what_if").(bool) {
+		return nil
+	}
+
+	// only run this once the deployment already exists - there's nothing to validate against
+	// for a brand new resource group scoped deployment beyond what `Create` will already do.
+	if d.Id() == "" {
+		return nil
+	}
+
+	client := meta.(*clients.Client).Resource.DeploymentsClient
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	properties := resources.DeploymentProperties{
+		Mode: resources.DeploymentMode(d.Get("deployment_mode").(string)),
+	}
+
+	if v, ok := d.GetOk("parameters"); ok {
+		params := v.(map[string]interface{})
+		newParams := make(map[string]interface{}, len(params))
+		for key, val := range params {
+			newParams[key] = struct {
+				Value interface{}
+			}{
+				Value: val,
+			}
+		}
+		properties.Parameters = &newParams
+	}
+
+	if v, ok := d.GetOk("parameters_body"); ok {
+		params, err := expandParametersBody(v.(string))
+		if err != nil {
+			return err
+		}
+		properties.Parameters = &params
+	}
+
+	if v, ok := d.GetOk("template_body"); ok {
+		template, err := expandTemplateBody(v.(string))
+		if err != nil {
+			return err
+		}
+		properties.Template = &template
+	}
+
+	validationResult, err := client.Validate(ctx, resourceGroup, name, resources.Deployment{Properties: &properties})
+	if err != nil {
+		return fmt.Errorf("running what-if validation for Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if validationResult.Error != nil {
+		if validationResult.Error.Message != nil {
+			return fmt.Errorf("what-if validation failed for Template Deployment %q (Resource Group %q): %s", name, resourceGroup, *validationResult.Error.Message)
+		}
+		return fmt.Errorf("what-if validation failed for Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, *validationResult.Error)
+	}
+
+	return nil
+}
+
 func resourceArmTemplateDeploymentCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Resource.DeploymentsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
@@ -347,6 +347,18 @@ func resourceArmStorageAccount() *schema.Resource {
 				},
 			},
 
+			"share_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cors_rule": azure.SchemaStorageAccountCorsRule(true),
+					},
+				},
+			},
+
 			"static_website": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -703,7 +715,26 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	if val, ok := d.GetOk("share_properties"); ok {
+		// BlockBlobStorage does not support file shares
+		if accountKind != string(storage.BlockBlobStorage) {
+			fileServicesClient := meta.(*clients.Client).Storage.FileServicesClient
+
+			shareProperties := expandShareProperties(val.([]interface{}))
+
+			if _, err = fileServicesClient.SetServiceProperties(ctx, resourceGroupName, storageAccountName, shareProperties); err != nil {
+				return fmt.Errorf("Error updating Azure Storage Account `share_properties` %q: %+v", storageAccountName, err)
+			}
+		} else {
+			return fmt.Errorf("`share_properties` aren't supported for BlockBlobStorage accounts.")
+		}
+	}
+
 	if val, ok := d.GetOk("queue_properties"); ok {
+		if !meta.(*clients.Client).Features.Storage.DataPlaneAvailable {
+			return fmt.Errorf("`queue_properties` cannot be set when `features.storage.data_plane_available` is `false`, since setting it requires a Queue Storage data-plane call")
+		}
+
 		storageClient := meta.(*clients.Client).Storage
 		account, err := storageClient.FindAccount(ctx, storageAccountName)
 		if err != nil {
@@ -733,6 +764,11 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 		if accountKind != string(storage.StorageV2) {
 			return fmt.Errorf("`static_website` is only supported for Storage V2.")
 		}
+
+		if !meta.(*clients.Client).Features.Storage.DataPlaneAvailable {
+			return fmt.Errorf("`static_website` cannot be set when `features.storage.data_plane_available` is `false`, since setting it requires a Storage Account data-plane call")
+		}
+
 		storageClient := meta.(*clients.Client).Storage
 
 		account, err := storageClient.FindAccount(ctx, storageAccountName)
@@ -912,7 +948,27 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	if d.HasChange("share_properties") {
+		// BlockBlobStorage does not support file shares
+		if accountKind != string(storage.BlockBlobStorage) {
+			fileServicesClient := meta.(*clients.Client).Storage.FileServicesClient
+			shareProperties := expandShareProperties(d.Get("share_properties").([]interface{}))
+
+			if _, err = fileServicesClient.SetServiceProperties(ctx, resourceGroupName, storageAccountName, shareProperties); err != nil {
+				return fmt.Errorf("Error updating Azure Storage Account `share_properties` %q: %+v", storageAccountName, err)
+			}
+
+			d.SetPartial("share_properties")
+		} else {
+			return fmt.Errorf("`share_properties` aren't supported for BlockBlobStorage accounts.")
+		}
+	}
+
 	if d.HasChange("queue_properties") {
+		if !meta.(*clients.Client).Features.Storage.DataPlaneAvailable {
+			return fmt.Errorf("`queue_properties` cannot be set when `features.storage.data_plane_available` is `false`, since setting it requires a Queue Storage data-plane call")
+		}
+
 		storageClient := meta.(*clients.Client).Storage
 		account, err := storageClient.FindAccount(ctx, storageAccountName)
 		if err != nil {
@@ -944,6 +1000,11 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 		if accountKind != string(storage.StorageV2) {
 			return fmt.Errorf("`static_website` is only supported for Storage V2.")
 		}
+
+		if !meta.(*clients.Client).Features.Storage.DataPlaneAvailable {
+			return fmt.Errorf("`static_website` cannot be set when `features.storage.data_plane_available` is `false`, since setting it requires a Storage Account data-plane call")
+		}
+
 		storageClient := meta.(*clients.Client).Storage
 
 		account, err := storageClient.FindAccount(ctx, storageAccountName)
@@ -1126,12 +1187,30 @@ func resourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
+	fileServicesClient := storageClient.FileServicesClient
+
+	// BlockBlobStorage does not support file shares
+	if resp.Kind != storage.BlockBlobStorage {
+		shareProps, err := fileServicesClient.GetServiceProperties(ctx, resGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(shareProps.Response) {
+				return fmt.Errorf("Error reading share properties for AzureRM Storage Account %q: %+v", name, err)
+			}
+		}
+
+		if err := d.Set("share_properties", flattenShareProperties(shareProps)); err != nil {
+			return fmt.Errorf("Error setting `share_properties `for AzureRM Storage Account %q: %+v", name, err)
+		}
+	}
+
 	// queue is only available for certain tier and kind (as specified below)
 	if resp.Sku == nil {
 		return fmt.Errorf("Error retrieving Storage Account %q (Resource Group %q): `sku` was nil", name, resGroup)
 	}
 
-	if resp.Sku.Tier == storage.Standard {
+	dataPlaneAvailable := meta.(*clients.Client).Features.Storage.DataPlaneAvailable
+
+	if resp.Sku.Tier == storage.Standard && dataPlaneAvailable {
 		if resp.Kind == storage.Storage || resp.Kind == storage.StorageV2 {
 			queueClient, err := storageClient.QueuesClient(ctx, *account)
 			if err != nil {
@@ -1154,7 +1233,7 @@ func resourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) err
 	var staticWebsite []interface{}
 
 	// static website only supported on Storage V2
-	if resp.Kind == storage.StorageV2 {
+	if resp.Kind == storage.StorageV2 && dataPlaneAvailable {
 		storageClient := meta.(*clients.Client).Storage
 
 		account, err := storageClient.FindAccount(ctx, name)
@@ -1420,6 +1499,27 @@ func expandBlobPropertiesCors(input []interface{}) *storage.CorsRules {
 	return &blobCorsRules
 }
 
+func expandShareProperties(input []interface{}) storage.FileServiceProperties {
+	props := storage.FileServiceProperties{
+		FileServicePropertiesProperties: &storage.FileServicePropertiesProperties{
+			Cors: &storage.CorsRules{
+				CorsRules: &[]storage.CorsRule{},
+			},
+		},
+	}
+
+	if len(input) == 0 || input[0] == nil {
+		return props
+	}
+
+	v := input[0].(map[string]interface{})
+
+	corsRaw := v["cors_rule"].([]interface{})
+	props.FileServicePropertiesProperties.Cors = expandBlobPropertiesCors(corsRaw)
+
+	return props
+}
+
 func expandQueueProperties(input []interface{}) (queues.StorageServiceProperties, error) {
 	var err error
 	properties := queues.StorageServiceProperties{
@@ -1643,6 +1743,27 @@ func flattenBlobProperties(input storage.BlobServiceProperties) []interface{} {
 	}
 }
 
+func flattenShareProperties(input storage.FileServiceProperties) []interface{} {
+	if input.FileServicePropertiesProperties == nil {
+		return []interface{}{}
+	}
+
+	flattenedCorsRules := make([]interface{}, 0)
+	if corsRules := input.FileServicePropertiesProperties.Cors; corsRules != nil {
+		flattenedCorsRules = flattenBlobPropertiesCorsRule(corsRules)
+	}
+
+	if len(flattenedCorsRules) == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"cors_rule": flattenedCorsRules,
+		},
+	}
+}
+
 func flattenBlobPropertiesCorsRule(input *storage.CorsRules) []interface{} {
 	corsRules := make([]interface{}, 0)
 
@@ -34,6 +34,7 @@ func (r Registration) SupportedDataSources() map[string]*schema.Resource {
 func (r Registration) SupportedResources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
 		"azurerm_app_service_active_slot":                      resourceArmAppServiceActiveSlot(),
+		"azurerm_app_service_app_settings":                     resourceArmAppServiceAppSettings(),
 		"azurerm_app_service_certificate":                      resourceArmAppServiceCertificate(),
 		"azurerm_app_service_certificate_order":                resourceArmAppServiceCertificateOrder(),
 		"azurerm_app_service_custom_hostname_binding":          resourceArmAppServiceCustomHostnameBinding(),
@@ -13,6 +13,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/web/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
@@ -436,6 +437,11 @@ func resourceArmAppServiceUpdate(d *schema.ResourceData, meta interface{}) error
 
 	// app settings updates have a side effect on logging settings. See the note below
 	if d.HasChange("app_settings") {
+		// shares a lock domain with `azurerm_app_service_app_settings`, since both read-modify-write
+		// the same App Settings map and would otherwise be able to race and clobber each other
+		locks.ByName(id.Name, appServiceAppSettingsResourceName)
+		defer locks.UnlockByName(id.Name, appServiceAppSettingsResourceName)
+
 		// update the AppSettings
 		appSettings := expandAppServiceAppSettings(d)
 		settings := web.StringDictionary{
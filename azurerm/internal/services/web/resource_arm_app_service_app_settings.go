@@ -0,0 +1,249 @@
+package web
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2019-08-01/web"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+var appServiceAppSettingsResourceName = "azurerm_app_service_app_settings"
+
+// resourceArmAppServiceAppSettings manages a *subset* of the Application Settings on an
+// App Service or Function App, keyed by `name`. Unlike the `app_settings` map on
+// `azurerm_app_service`/`azurerm_function_app` (which owns and overwrites the whole map),
+// this resource only ever adds/updates/removes the keys it was given - so settings pushed
+// by other tooling (e.g. a deployment pipeline stamping a version) are left untouched.
+func resourceArmAppServiceAppSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAppServiceAppSettingsCreateUpdate,
+		Read:   resourceArmAppServiceAppSettingsRead,
+		Update: resourceArmAppServiceAppSettingsCreateUpdate,
+		Delete: resourceArmAppServiceAppSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"app_service_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"app_settings": {
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceArmAppServiceAppSettingsCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.AppServicesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	appServiceName := d.Get("app_service_name").(string)
+	settings := expandAppServiceAppSettingsMap(d.Get("app_settings").(map[string]interface{}))
+
+	locks.ByName(appServiceName, appServiceAppSettingsResourceName)
+	defer locks.UnlockByName(appServiceName, appServiceAppSettingsResourceName)
+
+	id := fmt.Sprintf("%s|%s|%s", resourceGroup, appServiceName, name)
+
+	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+		existing, err := client.ListApplicationSettings(ctx, resourceGroup, appServiceName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing App Service %q (Resource Group %q): %+v", appServiceName, resourceGroup, err)
+			}
+		}
+
+		if existing.Properties != nil {
+			for key := range settings {
+				if _, exists := existing.Properties[key]; exists {
+					return fmt.Errorf("App Setting %q already exists on App Service %q (Resource Group %q) - import this key into a `azurerm_app_service_app_settings` resource, or remove it from the conflicting configuration, before managing it here", key, appServiceName, resourceGroup)
+				}
+			}
+		}
+	}
+
+	existing, err := client.ListApplicationSettings(ctx, resourceGroup, appServiceName)
+	if err != nil {
+		return fmt.Errorf("retrieving App Settings for App Service %q (Resource Group %q): %+v", appServiceName, resourceGroup, err)
+	}
+
+	merged := map[string]*string{}
+	if existing.Properties != nil {
+		for k, v := range existing.Properties {
+			merged[k] = v
+		}
+	}
+
+	// remove any keys this resource previously owned but no longer includes
+	if !d.IsNewResource() {
+		o, _ := d.GetChange("app_settings")
+		for key := range o.(map[string]interface{}) {
+			if _, stillOwned := settings[key]; !stillOwned {
+				delete(merged, key)
+			}
+		}
+	}
+
+	for key, value := range settings {
+		merged[key] = value
+	}
+
+	if _, err := client.UpdateApplicationSettings(ctx, resourceGroup, appServiceName, web.StringDictionary{Properties: merged}); err != nil {
+		return fmt.Errorf("updating App Settings for App Service %q (Resource Group %q): %+v", appServiceName, resourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmAppServiceAppSettingsRead(d, meta)
+}
+
+func resourceArmAppServiceAppSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.AppServicesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	resourceGroup, appServiceName, name, err := parseAppServiceAppSettingsID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("app_service_name", appServiceName)
+	d.Set("name", name)
+
+	resp, err := client.ListApplicationSettings(ctx, resourceGroup, appServiceName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] App Service %q (Resource Group %q) was not found - removing App Settings from state", appServiceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving App Settings for App Service %q (Resource Group %q): %+v", appServiceName, resourceGroup, err)
+	}
+
+	configured := d.Get("app_settings").(map[string]interface{})
+
+	owned := map[string]interface{}{}
+	if len(configured) == 0 {
+		// there's no "appSettingsGroups" concept in Azure - `name` only exists client-side, so
+		// there's nothing server-side to tell us which keys this resource is meant to own. This
+		// is the first Read after `terraform import`, so adopt every App Setting currently on the
+		// App Service; the next plan will diff that against the resource's `app_settings` config
+		// and the user can add/remove keys to reconcile it.
+		if resp.Properties != nil {
+			for key, v := range resp.Properties {
+				if v != nil {
+					owned[key] = *v
+				}
+			}
+		}
+	} else {
+		for key := range configured {
+			if resp.Properties != nil {
+				if v, ok := resp.Properties[key]; ok && v != nil {
+					owned[key] = *v
+					continue
+				}
+			}
+			// the key this resource owns has disappeared out-of-band - drop it so the next
+			// apply re-creates it, rather than silently keeping stale state.
+		}
+	}
+
+	if err := d.Set("app_settings", owned); err != nil {
+		return fmt.Errorf("setting `app_settings`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmAppServiceAppSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.AppServicesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	appServiceName := d.Get("app_service_name").(string)
+	settings := d.Get("app_settings").(map[string]interface{})
+
+	locks.ByName(appServiceName, appServiceAppSettingsResourceName)
+	defer locks.UnlockByName(appServiceName, appServiceAppSettingsResourceName)
+
+	existing, err := client.ListApplicationSettings(ctx, resourceGroup, appServiceName)
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving App Settings for App Service %q (Resource Group %q): %+v", appServiceName, resourceGroup, err)
+	}
+
+	if existing.Properties == nil {
+		return nil
+	}
+
+	for key := range settings {
+		delete(existing.Properties, key)
+	}
+
+	if _, err := client.UpdateApplicationSettings(ctx, resourceGroup, appServiceName, web.StringDictionary{Properties: existing.Properties}); err != nil {
+		return fmt.Errorf("removing App Settings from App Service %q (Resource Group %q): %+v", appServiceName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func parseAppServiceAppSettingsID(id string) (resourceGroup string, appServiceName string, name string, err error) {
+	segments := strings.Split(id, "|")
+	if len(segments) != 3 {
+		return "", "", "", fmt.Errorf("Expected ID to be in the format {resourceGroup}|{appServiceName}|{name} but got %q", id)
+	}
+
+	return segments[0], segments[1], segments[2], nil
+}
+
+func expandAppServiceAppSettingsMap(input map[string]interface{}) map[string]*string {
+	output := make(map[string]*string, len(input))
+	for k, v := range input {
+		output[k] = utils.String(v.(string))
+	}
+	return output
+}
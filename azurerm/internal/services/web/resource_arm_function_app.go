@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage"
 	webValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/web/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
@@ -513,6 +514,11 @@ func resourceArmFunctionAppUpdate(d *schema.ResourceData, meta interface{}) erro
 		Properties: appSettings,
 	}
 
+	// shares a lock domain with `azurerm_app_service_app_settings`, since both read-modify-write
+	// the same App Settings map and would otherwise be able to race and clobber each other
+	locks.ByName(id.Name, appServiceAppSettingsResourceName)
+	defer locks.UnlockByName(id.Name, appServiceAppSettingsResourceName)
+
 	if _, err = client.UpdateApplicationSettings(ctx, id.ResourceGroup, id.Name, settings); err != nil {
 		return fmt.Errorf("Error updating Application Settings for Function App %q: %+v", id.Name, err)
 	}
@@ -0,0 +1,295 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMAppServiceAppSettings_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_service_app_settings", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMAppServiceAppSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAppServiceAppSettings_basic(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceAppSettingsExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "app_settings.WEBSITE_RUN_FROM_PACKAGE", "1"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func TestAccAzureRMAppServiceAppSettings_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_service_app_settings", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMAppServiceAppSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAppServiceAppSettings_basic(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceAppSettingsExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "app_settings.%", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMAppServiceAppSettings_updated(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceAppSettingsExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "app_settings.%", "2"),
+					resource.TestCheckResourceAttr(data.ResourceName, "app_settings.ANOTHER_SETTING", "true"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func TestAccAzureRMAppServiceAppSettings_doesNotClobberUnmanagedSettings(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_service_app_settings", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMAppServiceAppSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAppServiceAppSettings_alongsideAppServiceSettings(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceAppSettingsExists(data.ResourceName),
+					testCheckAzureRMAppServiceAppSettingHasValue(data, "azurerm_app_service.test", "UNMANAGED_SETTING", "should-survive"),
+					testCheckAzureRMAppServiceAppSettingHasValue(data, "azurerm_app_service.test", "WEBSITE_RUN_FROM_PACKAGE", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMAppServiceAppSettingHasValue(data acceptance.TestData, appServiceResourceName, key, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Web.AppServicesClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+		rs, ok := s.RootModule().Resources[appServiceResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", appServiceResourceName)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		appServiceName := rs.Primary.Attributes["name"]
+
+		resp, err := client.ListApplicationSettings(ctx, resourceGroup, appServiceName)
+		if err != nil {
+			return err
+		}
+
+		if resp.Properties == nil {
+			return fmt.Errorf("App Settings for App Service %q (Resource Group %q) were nil", appServiceName, resourceGroup)
+		}
+
+		v, ok := resp.Properties[key]
+		if !ok || v == nil {
+			return fmt.Errorf("App Setting %q was not found on App Service %q (Resource Group %q)", key, appServiceName, resourceGroup)
+		}
+
+		if *v != expected {
+			return fmt.Errorf("App Setting %q had value %q but expected %q", key, *v, expected)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMAppServiceAppSettingsDestroy(s *terraform.State) error {
+	client := acceptance.AzureProvider.Meta().(*clients.Client).Web.AppServicesClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_app_service_app_settings" {
+			continue
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		appServiceName := rs.Primary.Attributes["app_service_name"]
+
+		resp, err := client.ListApplicationSettings(ctx, resourceGroup, appServiceName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			return err
+		}
+
+		if resp.Properties != nil {
+			if _, exists := resp.Properties["WEBSITE_RUN_FROM_PACKAGE"]; exists {
+				return fmt.Errorf("App Setting %q still exists on App Service %q (Resource Group %q)", "WEBSITE_RUN_FROM_PACKAGE", appServiceName, resourceGroup)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testCheckAzureRMAppServiceAppSettingsExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Web.AppServicesClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		appServiceName := rs.Primary.Attributes["app_service_name"]
+
+		resp, err := client.ListApplicationSettings(ctx, resourceGroup, appServiceName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("App Settings (App Service %q / Resource Group %q) do not exist", appServiceName, resourceGroup)
+			}
+			return err
+		}
+
+		if resp.Properties == nil {
+			return fmt.Errorf("App Settings (App Service %q / Resource Group %q) were nil", appServiceName, resourceGroup)
+		}
+
+		if _, exists := resp.Properties["WEBSITE_RUN_FROM_PACKAGE"]; !exists {
+			return fmt.Errorf("App Setting %q was not found on App Service %q (Resource Group %q)", "WEBSITE_RUN_FROM_PACKAGE", appServiceName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMAppServiceAppSettings_template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_app_service_plan" "test" {
+  name                = "acctestASP-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  sku {
+    tier = "Standard"
+    size = "S1"
+  }
+}
+
+resource "azurerm_app_service" "test" {
+  name                = "acctestAS-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  app_service_plan_id = azurerm_app_service_plan.test.id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
+
+func testAccAzureRMAppServiceAppSettings_basic(data acceptance.TestData) string {
+	template := testAccAzureRMAppServiceAppSettings_template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_service_app_settings" "test" {
+  name                = "acctestASAS-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  app_service_name    = azurerm_app_service.test.name
+
+  app_settings = {
+    "WEBSITE_RUN_FROM_PACKAGE" = "1"
+  }
+}
+`, template, data.RandomInteger)
+}
+
+func testAccAzureRMAppServiceAppSettings_updated(data acceptance.TestData) string {
+	template := testAccAzureRMAppServiceAppSettings_template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_service_app_settings" "test" {
+  name                = "acctestASAS-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  app_service_name    = azurerm_app_service.test.name
+
+  app_settings = {
+    "WEBSITE_RUN_FROM_PACKAGE" = "1"
+    "ANOTHER_SETTING"          = "true"
+  }
+}
+`, template, data.RandomInteger)
+}
+
+func testAccAzureRMAppServiceAppSettings_alongsideAppServiceSettings(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_app_service_plan" "test" {
+  name                = "acctestASP-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  sku {
+    tier = "Standard"
+    size = "S1"
+  }
+}
+
+resource "azurerm_app_service" "test" {
+  name                = "acctestAS-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  app_service_plan_id = azurerm_app_service_plan.test.id
+
+  app_settings = {
+    "UNMANAGED_SETTING" = "should-survive"
+  }
+
+  lifecycle {
+    ignore_changes = [app_settings]
+  }
+}
+
+resource "azurerm_app_service_app_settings" "test" {
+  name                = "acctestASAS-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  app_service_name    = azurerm_app_service.test.name
+
+  app_settings = {
+    "WEBSITE_RUN_FROM_PACKAGE" = "1"
+  }
+
+  depends_on = [azurerm_app_service.test]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"fmt"
 	"net"
 	"net/http"
 
@@ -44,3 +45,27 @@ func ResponseWasStatusCode(resp autorest.Response, statusCode int) bool { // nol
 
 	return false
 }
+
+// AzureRequestCorrelationDetails returns the `x-ms-request-id` and `x-ms-correlation-request-id`
+// values from the raw HTTP response of an Azure API call, formatted for appending to an error
+// message so that a support ticket can be correlated against the service-side logs.
+func AzureRequestCorrelationDetails(resp autorest.Response) string {
+	r := resp.Response
+	if r == nil {
+		return ""
+	}
+
+	requestID := r.Header.Get("x-ms-request-id")
+	correlationID := r.Header.Get("x-ms-correlation-request-id")
+
+	switch {
+	case requestID != "" && correlationID != "":
+		return fmt.Sprintf(" (Request ID %q, Correlation Request ID %q)", requestID, correlationID)
+	case requestID != "":
+		return fmt.Sprintf(" (Request ID %q)", requestID)
+	case correlationID != "":
+		return fmt.Sprintf(" (Correlation Request ID %q)", correlationID)
+	default:
+		return ""
+	}
+}